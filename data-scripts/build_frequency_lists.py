@@ -41,6 +41,8 @@ DICTIONARIES = dict(
 def parse_frequency_lists(data_dir):
     freq_lists = {}
     for filename in os.listdir(data_dir):
+        if os.path.isdir(os.path.join(data_dir, filename)):
+            continue # wordlists, see build_wordlists.py
         freq_list_name, ext = os.path.splitext(filename)
         if freq_list_name not in DICTIONARIES:
             msg = 'Warning: %s appears in %s directory but not in DICTIONARY settings. Excluding.'
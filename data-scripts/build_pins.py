@@ -0,0 +1,43 @@
+#!/usr/bin/python
+import codecs
+import sys
+
+def usage():
+    return '''
+usage:
+%s passwords.txt pins.go
+
+generates pins.go (the common PINs) from the "<password> <count>" lines of passwords.txt:
+the passwords made of 4 to 8 digits, most frequent first.
+    ''' % sys.argv[0]
+
+MIN_LENGTH = 4
+MAX_LENGTH = 8
+
+def is_pin(password):
+    return MIN_LENGTH <= len(password) <= MAX_LENGTH and all('0' <= c <= '9' for c in password)
+
+def main():
+    if len(sys.argv) != 3:
+        print(usage())
+        sys.exit(0)
+    passwords_file, output_file = sys.argv[1:]
+    pins = []
+    with codecs.open(passwords_file, 'r', 'utf8') as f:
+        for line in f:
+            parts = line.rstrip('\n').rsplit(None, 1)
+            if len(parts) == 2 and is_pin(parts[0]):
+                pins.append((parts[0], int(parts[1])))
+    pins.sort(key=lambda item: -item[1])
+    with codecs.open(output_file, 'w', 'utf8') as f:
+        f.write('package frequency // generated by build_pins.py\n\n')
+        f.write('// PINs are the passwords of 4 to 8 digits of the passwords frequency list, most\n')
+        f.write('// frequent first. They are embedded whatever the build tags, for the PIN mode of\n')
+        f.write('// the estimator.\n')
+        f.write('var PINs = []string{\n')
+        for i in range(0, len(pins), 10):
+            f.write('\t%s,\n' % ', '.join('"%s"' % p for p, _ in pins[i:i+10]))
+        f.write('}\n')
+
+if __name__ == '__main__':
+    main()
@@ -0,0 +1,58 @@
+#!/usr/bin/python
+import codecs
+import sys
+from collections import Counter
+
+def usage():
+    return '''
+usage:
+%s passwords.txt structures.go
+
+generates structures.go (the base structures of passwords and their counts) from the
+"<password> <count>" lines of passwords.txt.
+
+a base structure is the sequence of letter (L), digit (D) and symbol (S) runs of a password
+with their lengths: "password1" is L8D1 and "jan.2019" is L3S1D4.
+    ''' % sys.argv[0]
+
+def char_class(c):
+    if c.isalpha():
+        return 'L'
+    if c.isdecimal():
+        return 'D'
+    return 'S'
+
+def structure(password):
+    runs = []
+    for c in password:
+        k = char_class(c)
+        if runs and runs[-1][0] == k:
+            runs[-1][1] += 1
+        else:
+            runs.append([k, 1])
+    return ''.join('%s%d' % (k, n) for k, n in runs)
+
+def main():
+    if len(sys.argv) != 3:
+        print(usage())
+        sys.exit(0)
+    passwords_file, output_file = sys.argv[1:]
+    counts = Counter()
+    with codecs.open(passwords_file, 'r', 'utf8') as f:
+        for line in f:
+            parts = line.rstrip('\n').rsplit(None, 1)
+            if len(parts) != 2:
+                continue
+            counts[structure(parts[0])] += int(parts[1])
+    with codecs.open(output_file, 'w', 'utf8') as f:
+        f.write('package frequency // generated by build_structures.py\n\n')
+        f.write('// Structures counts the base structures of the passwords of the passwords\n')
+        f.write('// frequency list: runs of letters (L), digits (D) and symbols (S) with their\n')
+        f.write('// lengths, such as L8D1 for "password1". They are embedded whatever the build tags.\n')
+        f.write('var Structures = map[string]int{\n')
+        for s, n in sorted(counts.items(), key=lambda item: (-item[1], item[0])):
+            f.write('\t"%s": %d,\n' % (s, n))
+        f.write('}\n')
+
+if __name__ == '__main__':
+    main()
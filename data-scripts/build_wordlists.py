@@ -0,0 +1,43 @@
+#!/usr/bin/python
+import codecs
+import json
+import os
+import sys
+
+def usage():
+    return '''
+usage:
+%s wordlists-dir wordlists.go
+
+generates wordlists.go (the passphrase generators' word lists) from the diceware word lists
+of wordlists-dir, in the format of the EFF lists: one "<dice rolls><tab><word>" line per word.
+    ''' % sys.argv[0]
+
+# maps list name to file name
+WORDLISTS = dict(
+    diceware  = 'diceware.txt',
+    eff_large = 'eff_large_wordlist.txt',
+    eff_short = 'eff_short_wordlist.txt',
+)
+
+def parse_wordlist(path):
+    with codecs.open(path, 'r', 'utf8') as f:
+        return [line.rstrip('\n').split('\t', 1)[1] for line in f if line.strip()]
+
+def main():
+    if len(sys.argv) != 3:
+        print(usage())
+        sys.exit(0)
+    data_dir, output_file = sys.argv[1:]
+    with codecs.open(output_file, 'w', 'utf8') as f:
+        f.write('package frequency // generated by build_wordlists.py\n\n')
+        f.write('// Wordlists are the word lists of passphrase generators: the original diceware\n')
+        f.write('// list and the EFF large and short lists. Words are in dice roll order.\n')
+        f.write('var Wordlists = map[string][]string{\n')
+        for name in sorted(WORDLISTS):
+            words = parse_wordlist(os.path.join(data_dir, WORDLISTS[name]))
+            f.write('\t"%s": {%s},\n' % (name, ', '.join(json.dumps(w) for w in words)))
+        f.write('}\n')
+
+if __name__ == '__main__':
+    main()
@@ -2,4 +2,10 @@
 python build_frequency_lists.py ../data ../frequency/lists.go
 go fmt ../frequency/lists.go
 python build_keyboard_adjacency_graphs.py ../adjacency/graphs.go
-go fmt ../adjacency/graphs.go
\ No newline at end of file
+go fmt ../adjacency/graphs.go
+python build_wordlists.py ../data/wordlists ../frequency/wordlists.go
+go fmt ../frequency/wordlists.go
+python build_structures.py ../data/passwords.txt ../frequency/structures.go
+go fmt ../frequency/structures.go
+python build_pins.py ../data/passwords.txt ../frequency/pins.go
+go fmt ../frequency/pins.go
@@ -0,0 +1,177 @@
+package frequency // generated by build_pins.py
+
+// PINs are the passwords of 4 to 8 digits of the passwords frequency list, most
+// frequent first. They are embedded whatever the build tags, for the PIN mode of
+// the estimator.
+var PINs = []string{
+	"123456", "12345678", "12345", "1234", "111111", "1234567", "123123", "696969", "666666", "123321",
+	"654321", "7777777", "000000", "112233", "11111111", "131313", "159753", "6969", "123654", "12344321",
+	"8675309", "159357", "789456", "5150", "2112", "01012011", "102030", "11223344", "315475", "007007",
+	"111222", "147258", "010203", "147852", "420420", "123789", "9379992", "852456", "159951", "134679",
+	"12312", "01011980", "01011", "124578", "01012000", "135790", "142536", "741852", "456123", "666999",
+	"246810", "753951", "31415926", "314159", "01011990", "321654", "141627", "1478963", "13579", "951753",
+	"11235813", "1234321", "14789632", "911911", "112358", "555666", "7895123", "01012010", "4128", "121314",
+	"456852", "2468", "123098", "1701", "25802580", "0007", "12369874", "258456", "1122", "12312312",
+	"102938", "123987", "445566", "11112222", "987456", "6751520", "12121", "456654", "753159", "01012001",
+	"1221", "223344", "906090", "789654", "666777", "123457", "01011991", "15426378", "132435", "1366613",
+	"963852", "000007", "12332", "362436", "01011985", "73501505", "1225", "01011970", "128500", "01011981",
+	"789123", "01011986", "1066", "321123", "100000", "1001", "150781", "13243546", "222333", "78945612",
+	"18436572", "321456", "332211", "420247", "162534", "456321", "000001", "789987", "1000", "135246",
+	"122333", "12131415", "7753191", "10203", "200000", "01011989", "0420", "2128506", "01011988", "778899",
+	"1024", "555777", "112211", "192837", "01011984", "1357", "01011987", "777888", "333666", "02071986",
+	"03082006", "4121", "336699", "10203040", "1012", "90210", "01011910", "12345679", "1007", "10101",
+	"123451", "784512", "01011992", "11223", "19411945", "01091989", "14725836", "235689", "787898", "5551212",
+	"02071982", "01011975", "01011993", "7779311", "1005", "1213", "02021987", "02011985", "02081988", "147369",
+	"02041986", "01011977", "02051986", "02091987", "12011987", "02101985", "02031986", "02021988", "1369", "1492",
+	"794613", "02061985", "654123", "1020", "1017", "02011987", "111333", "02091986", "02021986", "1236987",
+	"000111", "369963", "01011983", "02081984", "02081987", "02061986", "01011982", "02021984", "02031984", "02021985",
+	"01020304", "123455", "02081989", "21031988", "2580", "01011999", "02011986", "02061989", "02041984", "02021983",
+	"120676", "147963", "1123", "02021989", "02041983", "02051983", "9562876", "159632", "02031987", "02011988",
+	"02081986", "1022", "1223", "02041982", "02041988", "02041987", "15975", "02011980", "2469", "01011979",
+	"1011", "02101984", "010180", "12365", "02081985", "1224", "1211", "02071984", "02021982", "655321",
+	"123465", "12365478", "998877", "02061988", "02031985", "147741", "258852", "4417", "69696", "02081982",
+	"7007", "02051982", "02011984", "02031982", "02061980", "225588", "369258", "1234560", "1000000", "02061987",
+	"01081989", "02091983", "369852", "1112", "02101987", "1023", "05051987", "02041985", "12051988", "02101989",
+	"1013", "02071980", "02071987", "02091981", "123000", "02041981", "02061983", "02091980", "02091984", "01011900",
+	"02051987", "02071988", "78945", "02041979", "05051985", "153624", "02051988", "1121", "02081977", "333777",
+	"22041987", "02061984", "02031981", "08031986", "02051984", "02051989", "15051981", "26061987", "02021979", "02061982",
+	"02091985", "11051987", "111000", "10011986", "987123", "01031988", "1215", "21031987", "13041988", "06061986",
+	"02021981", "1002", "135791", "02041989", "01011978", "02101986", "02011989", "74108520", "12021988", "01061990",
+	"02071981", "01011960", "13041987", "02021976", "30051985", "03041991", "02031979", "24061986", "14061991", "21011989",
+	"11081989", "20061988", "02081983", "22021989", "23041987", "02011981", "01121986", "172839", "1125", "1102",
+	"18011987", "01071986", "02071983", "02021973", "420000", "1031", "02091989", "02071989", "07071987", "635241",
+	"1812", "14111986", "10031988", "01041985", "19101987", "13031987", "24011985", "02081980", "28041987", "02101988",
+	"25081988", "01091987", "02021990", "19061987", "12121990", "10071987", "13061986", "17051988", "10051987", "20111986",
+	"01011995", "25800852", "28021992", "10101986", "03041986", "01121988", "08121986", "987321", "14021985", "1776",
+	"02031980", "04041991", "10011990", "09051945", "02101983", "12121985", "22011988", "02101981", "11061985", "02031989",
+	"02041980", "27061988", "30041986", "11051990", "24680", "01061986", "01041988", "196969", "29071983", "25031987",
+	"21031990", "01011994", "29051989", "20031987", "02051980", "04041988", "0000007", "28011987", "16051989", "25121987",
+	"16051987", "08051990", "20091991", "1210", "05051989", "29011985", "28021990", "100500", "415263", "22021986",
+	"02011983", "17061988", "1003", "18061990", "12031985", "12031987", "224466", "15011987", "02031977", "08081988",
+	"21051991", "02071978", "18091985", "02031988", "31011987", "20051988", "11121986", "01031989", "30031988", "02041974",
+	"20091988", "1204", "15051990", "03031986", "01011974", "02071979", "1234123", "02051978", "08031985", "13121985",
+	"02011982", "22071986", "02101979", "02051985", "4200", "02051976", "15101986", "21101986", "14021986", "25091987",
+	"16121987", "02041975", "17011987", "10101990", "22031984", "15021985", "01031985", "26031988", "13021990", "02051973",
+	"142857", "25041988", "07091990", "1124", "23021986", "999666", "02051981", "01021990", "24111989", "21051988",
+	"22041988", "258369", "19283746", "02051972", "132456", "357159", "145236", "741963", "02041978", "02031978",
+	"02051977", "258963", "07071977", "02081976", "01011976", "7896321", "333444", "02071975", "147896", "02061977",
+	"02031975", "123567", "1008", "875421", "02041977", "357951", "02071976", "02061976", "02101976", "111777",
+	"02041976", "5329", "996633", "556677", "223322", "3006", "1235789", "22061941", "02031973", "5201314",
+	"02021971", "02011975", "123459", "1004", "02091976", "132465", "01011971", "02051975", "02101977", "02091975",
+	"02091977", "1598753", "01011973", "02091973", "14881488", "515000", "02081974", "02071971", "10293847", "12348765",
+	"222777", "777999", "02091971", "1234566", "777333", "02061974", "02061972", "32167", "02101973", "888999",
+	"02041973", "1234561", "1234568", "115599", "321678", "951357", "02081973", "02051970", "1357911", "02031974",
+	"44332211", "01011972", "66613666", "02041972", "02061971", "02011971", "18121812", "123450", "02081970", "334455",
+	"111555", "5000", "123890", "777666", "1231234", "963258", "1237895", "456987", "02031970", "333555",
+	"159263", "22446688", "918273", "0001", "7412369", "14785236", "13131", "12345677", "114477", "01011950",
+	"444555", "902100", "999888", "999000", "741258", "99762000", "852258", "3000", "986532", "9111961",
+	"214365", "895623", "777555", "515051", "01011961", "25252", "124038", "1475369", "24681012", "258000",
+	"1009", "74123698", "748596", "651550", "666333", "110442", "12349876", "12345687", "6661313", "9874123",
+	"232425", "11001001", "3141592", "333999", "153759", "12345123", "123412", "27731828", "987789", "666555",
+	"615243", "753357", "4455", "555222", "3984240", "3698741", "12340987", "1122334", "22334455", "12345612",
+	"13245768", "837519", "222555", "665544", "1006", "141516", "74227422", "43046721", "007700", "159852",
+	"1235813", "777111", "555333", "18273645", "357753", "335577", "1596321", "3004", "823762", "777000",
+	"3151020", "699669", "1230123", "11122233", "362514", "222444", "885522", "999111", "1234569", "78963214",
+	"224488", "69213124", "622521", "747400", "852963", "25000", "123698", "3001", "088011", "999777",
+	"3003", "01478520", "123458", "34523452", "427900", "123258", "1725782", "253634", "515253", "12345432",
+	"74185296", "32165498", "13572468", "9000", "456258", "1212121", "333221", "123454", "963369", "48151623",
+	"10000", "13576479", "315920", "322223", "124356", "131415", "555000", "135799", "555556", "789852",
+	"145632", "1478520", "500000", "233223", "1233211", "1234432", "123369", "3234412", "3263827", "333222",
+	"124816", "7415963", "100001", "444777", "271828", "777444", "08154711", "1231231", "1233210", "014789",
+	"1475963", "013579", "2234562", "135531", "5641110", "001100", "7555545", "12233445", "524645", "555444",
+	"5550666", "212223", "235711", "451236", "479373", "554455", "12332112", "555888", "13579246", "741236",
+	"96385274", "258741", "852654", "951159", "968574", "335533", "963741", "976431", "11121314", "21125150",
+	"4000", "567765", "123666", "4637324", "000006", "123333", "19844891", "789321", "242526", "44445555",
+	"475869", "19933991", "07831505", "009900", "123555", "08522580", "12347890", "12345671", "222888", "224422",
+	"12345666", "11924704", "25251325", "019283", "113322", "123234", "2583458", "1212123", "1001001", "19877891",
+	"557744", "555111", "133113", "135792", "20001", "333888", "43211234", "123432", "123444", "113355",
+	"2580456", "55832811", "999333", "45645", "99887766", "178500", "98745632", "192168", "282860", "654456",
+	"852147", "123345", "152535", "12312345", "18821221", "23049307", "3008", "198200", "55556666", "203040",
+	"0070", "852741", "159874", "19391945", "5550123", "7550055", "171819", "9788960", "232629", "098123",
+	"114411", "326598", "225522", "252627", "00133", "300000", "197000", "654987", "667788", "774411",
+	"54132442", "852123", "1233214", "1020304", "900000", "889988", "789520", "775533", "3005", "3009",
+	"669966", "49527843", "153426", "0000001", "95175", "159487", "159963", "187211", "42042042", "258025",
+	"00007", "197500", "198000", "15987532", "12345689", "00096462", "62717315", "8008", "19922991", "75395",
+	"78678", "098890", "0137485", "161718", "12481632", "12346789", "123580", "147123", "4071505", "666111",
+	"616913", "557711", "555999", "444222", "430799", "313233", "331234", "543216", "996699", "1010101",
+	"1123456", "1234565", "1234576", "1598741", "2741001", "123963", "12345600", "247365", "04975756", "52678677",
+	"55667788", "5005", "77777778", "198500", "0003", "98741236", "96321478", "24681357", "15975321", "492529",
+	"666888", "00001", "1357900", "3364068", "06225930", "151617", "74185", "45454", "10111213", "3007",
+	"19966991", "19992000", "34524815", "199000", "09877890", "99941", "15975346", "14938685", "119911", "12435687",
+	"12332145", "123645", "123777", "8318131", "7558795", "5552555", "4500455", "666000", "555551", "144000",
+	"00197400", "272829", "159123", "06060", "246800", "234523", "38972091", "40028922", "51842543", "215487",
+	"133159", "125678", "998899", "233391", "999998", "1232123", "198400", "1313666", "1314520", "13324124",
+	"1596357", "6000", "7355608", "8543852", "9632147", "36460341", "77778888", "81726354", "86753099", "88351132",
+	"88889999", "07070", "227722", "369147", "183461", "481516", "135711", "526452", "554433", "024680",
+	"0009", "123423", "21436587", "106666", "258789", "000009", "20000", "123400", "09090", "36987412",
+	"66669999", "198900", "302731", "0002", "195000", "375125", "148888", "556655", "666425", "12342000",
+	"778811", "1123581", "1236547", "1357246", "2481632", "5782790", "005500", "4002", "04325956", "7894561",
+	"6820055", "6666667", "6031769", "3891576", "3334444", "2835493", "179355", "1654321", "1453145", "158272",
+	"1234578", "1234512", "975310", "889900", "888111", "12332100", "12345698", "708090", "703751", "700007",
+	"697769", "616879", "600000", "557799", "556699", "446655", "444666", "444333", "442244", "392781",
+	"345543", "339311", "332233", "197800", "198300", "199200", "7000", "125478", "074401", "123213",
+	"36936", "78787", "19216801", "19899891", "19977991", "113311", "222999", "234432", "22221111", "13245678",
+	"3247562", "13579135", "006900", "5681392", "6345789", "7224763", "8902792", "198700", "135795", "92702689",
+	"7001", "44448888", "15975300", "267605", "316769", "123211", "405060", "007008", "456838", "213141",
+	"464811", "000005", "678910", "157953", "741147", "66699", "775577", "777771", "19866891", "824655",
+	"867530", "0072563", "908070", "995511", "5003", "276115", "1020315", "00000001", "1232323", "1234599",
+	"22223333", "1597530", "19733791", "56565", "34778", "9009", "123445", "123678", "33334444", "46775575",
+	"57392632", "85852008", "98798798", "137946", "00009999", "0006", "0010", "14071789", "147789", "02020",
+	"12345670", "12343412", "12123434", "155555", "159789", "11335577", "4001", "4034407", "3657549", "3630000",
+	"3578951", "1726354", "1357642", "1010220", "926337", "888555", "785612", "779977", "667766", "666420",
+	"579300", "555123", "526282", "455445", "443322", "316497", "197100", "198600", "07931505", "284655",
+	"222666", "223311", "246890", "246824", "19719870", "164379", "166666", "167943", "00998877", "44556",
+	"8546404", "36363", "6060842", "5551298", "00000007", "2521659", "1593570", "1472583", "1362840", "85200258",
+	"963147", "131517", "800500", "789551", "786110", "754321", "709394", "477041", "444000", "442200",
+	"428054", "337733", "336633", "334433", "333000", "196400", "197300", "197600", "198910", "198920",
+	"200001", "146969", "12345789", "12345611", "261397", "01478963", "151500", "153351", "154263", "258147",
+	"255225", "11223355", "19955991", "123579", "4007", "123852", "241455", "125412", "125521", "19911992",
+	"125689", "78978", "9811020", "0008", "0005", "8481068", "000002", "6969696", "5792076", "4206969",
+	"3440172", "2597174", "1597532", "1357913", "1313131", "1232580", "1011111", "888777", "123342", "78621323",
+	"789963", "123452", "123589", "785001", "777222", "67390436", "66005918", "58565254", "182838", "666222",
+	"665259", "52545856", "51525354", "45645645", "44556677", "556644", "527952", "37583867", "515069", "36925814",
+	"493949", "31415927", "424365", "382436", "369741", "09080706", "196800", "196820", "197430", "30624700",
+	"198206", "198207", "132333", "198701", "199103", "199430", "14159265", "14142135", "149521", "9004",
+	"12356789", "12345699", "10048", "0112358", "159456", "11251422", "11223311", "11223300", "19944991", "11111118",
+	"223366", "226622", "00700", "255555", "19755791", "243122", "18254288", "02551670", "228822", "224455",
+	"204060", "0101198", "199410", "198800", "198020", "197200", "316271", "365214", "382563", "414243",
+	"441232", "444888", "483422", "545645", "665566", "666444", "687887", "747200", "789056", "880888",
+	"887766", "1010321", "1233215", "1346795", "1512198", "2022958", "2121212", "2525252", "2797349", "3816778",
+	"5556633", "7085506", "7506751", "9124852", "9556035", "0147852", "11119999", "12457896", "144444", "143000",
+	"4060", "137955", "15975391", "125690", "124365", "123978", "123699", "123592", "000008", "007000",
+	"6001", "08080", "98766789", "87062134", "61808861", "57699434", "55495746", "19372846", "19380018", "51502112",
+	"19822891", "46466452", "19855891", "119966", "115511", "10078", "14028", "17098", "50000", "54343",
+	"54354", "028526", "159000", "199020", "12213443", "12758698", "07078", "198520", "198505", "145678",
+	"142500", "141592", "197700", "13467985", "197101", "197010", "196100", "4030", "311420", "342500",
+	"136900", "135642", "420666", "15253545", "15975312", "444111", "500600", "511647", "5001", "543211",
+	"552255", "552861", "125267", "125000", "124536", "123888", "645202", "258046", "777123", "223355",
+	"0080", "6070", "888889", "03038", "1111112", "76689295", "1169900", "1231230", "56836803", "1237654",
+	"55378008", "19216811", "1357924", "19801982", "19821983", "19831985", "19833891", "19921993", "1597535", "36985214",
+	"117711", "19932916", "31359092", "201980", "31021364", "2580258", "6942987", "8520456", "8538622", "8807031",
+	"9875321", "9933162", "008800", "10020", "15058", "26058", "002200", "77879", "165432", "24688642",
+	"24861793", "78791", "29024", "26028", "20068", "20038", "18068", "14058", "14038", "108888",
+	"9007", "9001", "8090", "36169544", "118801", "118811", "119955", "19891959", "45678912", "19841989",
+	"19801984", "19761977", "51501984", "55443322", "67899876", "78978978", "88887777", "91328378", "98256518", "0020",
+	"123569", "129834", "15935746", "132546", "132613", "135798", "136666", "136913", "14314314", "02588520",
+	"13571113", "13467982", "143333", "06068", "12345656", "153246", "11234567", "11114444", "11012566", "9512369",
+	"9293709", "9104587", "9001668", "8522003", "6741314", "5557940", "5455555", "5318008", "4930321", "4707570",
+	"4258195", "3434245", "2505198", "2323232", "2008200", "1766734", "1478523", "1371280", "1239056", "1236798",
+	"1231233", "1112223", "776677", "774477", "773400", "744637", "741776", "00198", "718293", "666123",
+	"663366", "523252", "486255", "432100", "423956", "400000", "321671", "197901", "198305", "198603",
+	"199308", "199500", "199508", "200007", "212325", "266643", "00948230", "04088", "258012", "231456",
+	"232222", "233307", "234561", "235555", "248624", "246801", "12101492", "157359", "26048", "24048",
+	"9008", "0040", "20058", "0187541", "9517883", "9035768", "7106189", "6657684", "05058", "4050328",
+	"172165", "3214789", "2947251", "177777", "179328", "2580147", "19038", "2236345", "1593575", "1590753",
+	"1478965", "1258963", "1236951", "1234556", "1213456", "06251106", "999555", "997755", "951623", "907629",
+	"888666", "887788", "787899", "123233", "01470258", "777007", "123478", "776655", "123498", "741369",
+	"96385", "124563", "125480", "699999", "696977", "187420", "125896", "127266", "127576", "636332",
+	"567432", "565758", "552233", "551155", "0606198", "542678", "495812", "444455", "14078", "422119",
+	"369987", "361619", "358853", "345123", "196500", "197506", "197610", "197802", "198100", "198510",
+	"198620", "198707", "198802", "198803", "198810", "199004", "199090", "15541632", "15161718", "199404",
+	"199406", "0406198", "199520", "199610", "22360679", "286685", "134267", "134652", "135789", "138500",
+	"22228888", "22224444", "02143006", "4080", "4050", "213456", "086421", "33669", "113411", "33331111",
+	"113456", "33445566", "114466", "116211", "41513042", "19888891", "04098", "19861987", "45683968", "19811983",
+	"147000", "222221", "222223", "226688", "149200", "19688691", "10088", "56259090", "29048", "29038",
+	"66778899", "71727374", "74125896", "78789898", "246969", "246642", "12601196", "12378945", "91929394", "12345543",
+	"12341231", "12141618",
+}
@@ -0,0 +1,562 @@
+package frequency // generated by build_structures.py
+
+// Structures counts the base structures of the passwords of the passwords
+// frequency list: runs of letters (L), digits (D) and symbols (S) with their
+// lengths, such as L8D1 for "password1". They are embedded whatever the build tags.
+var Structures = map[string]int{
+	"L6":                               778076,
+	"L7":                               447561,
+	"L8":                               405006,
+	"L5":                               206682,
+	"L4":                               123162,
+	"D6":                               119830,
+	"L5D1":                             60580,
+	"D8":                               51302,
+	"L7D1":                             49121,
+	"L9":                               46466,
+	"L6D1":                             38186,
+	"L6D2":                             21599,
+	"L10":                              18667,
+	"D9":                               18256,
+	"D4":                               15669,
+	"D7":                               14856,
+	"D5":                               14304,
+	"L3D3":                             13614,
+	"L5D3":                             13206,
+	"L4D2":                             11824,
+	"L5D2":                             8838,
+	"L4D4":                             8402,
+	"L4D3":                             7759,
+	"D10":                              5833,
+	"D6L1":                             5301,
+	"L11":                              4934,
+	"D3L3":                             4879,
+	"L8D1":                             4680,
+	"L4D1":                             4081,
+	"D1L6":                             3966,
+	"L6D3":                             3831,
+	"L3D4":                             3639,
+	"D1L5":                             3138,
+	"D1L3D1L3":                         3009,
+	"D1L7":                             2972,
+	"L12":                              2746,
+	"D5L1":                             2465,
+	"D4L4":                             2212,
+	"L1D1L1D1L1D1L1D1":                 2119,
+	"D9L1":                             2103,
+	"L5D4":                             2005,
+	"L1D1L1D1L1D1":                     1860,
+	"L1D5":                             1655,
+	"D1L1D1L1D1L1D1L1":                 1601,
+	"D7L1":                             1568,
+	"L1D1L1D1L1D1L1D1L1D1":             1528,
+	"L1D6":                             1525,
+	"D2L6":                             1442,
+	"D3L5":                             1287,
+	"L2D4":                             1281,
+	"L5D1L2":                           1278,
+	"D1L1D1L1D1L1":                     1216,
+	"D1L6D1":                           1030,
+	"L7D3":                             962,
+	"L7D2":                             959,
+	"L8D2":                             950,
+	"D1L1D1L1D1L1D1L1D1L1":             927,
+	"D6L2":                             912,
+	"L3D5":                             907,
+	"L9D1":                             900,
+	"D1L4":                             897,
+	"L3":                               880,
+	"L3D1L2":                           835,
+	"L3D4L1":                           795,
+	"L4D1L1":                           788,
+	"L2D3":                             715,
+	"L1D7":                             696,
+	"D10L1":                            693,
+	"L3D2L3":                           691,
+	"D4L2":                             689,
+	"D2L4":                             668,
+	"L1D1L4":                           668,
+	"D11":                              661,
+	"L4D1L2":                           649,
+	"L2D6":                             632,
+	"L13":                              627,
+	"D2L5":                             625,
+	"L8D3":                             610,
+	"D8L1":                             584,
+	"D5L5":                             575,
+	"D3L6":                             565,
+	"L6D4":                             543,
+	"L2D1L3":                           539,
+	"L3D1":                             525,
+	"D6L3":                             502,
+	"L10D1":                            495,
+	"L3D1L4":                           469,
+	"D3L3D3":                           468,
+	"D3L4":                             467,
+	"L3D2":                             466,
+	"D4L3":                             461,
+	"L4D1L3":                           460,
+	"D12":                              459,
+	"L2D1L5":                           449,
+	"L2D3L2":                           372,
+	"D5L3":                             345,
+	"L3D1L3":                           344,
+	"L3D3L3":                           339,
+	"L1D9":                             337,
+	"L2D2L2D2":                         329,
+	"L5D5":                             324,
+	"L2D2L4":                           315,
+	"L3D6":                             314,
+	"L9D3":                             313,
+	"D3L9":                             310,
+	"L3D1L3D1":                         300,
+	"L4D3L4":                           288,
+	"L2D5":                             284,
+	"L1D1L1D1L1D1L1D1L1D1L1D1":         269,
+	"D1L3D1L3D1L3":                     267,
+	"L1D1L6":                           255,
+	"D2L2D2":                           252,
+	"L4D5":                             250,
+	"L1D2L3":                           249,
+	"D1L3D1L1":                         248,
+	"D3L2":                             247,
+	"D5L2":                             247,
+	"L3D3L2":                           242,
+	"D6L6":                             235,
+	"L6D6":                             235,
+	"L6D5":                             231,
+	"D1L1D1L1D1L1D1L1D1L1D1L1":         229,
+	"L2D2L2":                           229,
+	"D1L3":                             225,
+	"L2D1L2D1":                         217,
+	"D2L2D2L2":                         213,
+	"D9L3":                             208,
+	"L2D1L1D1L1":                       205,
+	"L5D1L1":                           205,
+	"L3D1L2D1L1":                       203,
+	"D1L4D1":                           200,
+	"D3L1D3":                           199,
+	"L2D1L4":                           193,
+	"D1L1D1L2D1L2":                     190,
+	"L4S1L6":                           190,
+	"D1S1D1S1D3":                       186,
+	"L11D4":                            184,
+	"L7D4":                             183,
+	"D3":                               180,
+	"L3D1L1D1":                         180,
+	"L4D1L4":                           170,
+	"L6D1L1":                           162,
+	"L1D8":                             160,
+	"D1L1D1L3":                         159,
+	"D1L4D1L1":                         158,
+	"L2D2L1D1L2":                       158,
+	"L1D6L1":                           152,
+	"L2D2L3":                           149,
+	"D3L2D3":                           148,
+	"L14":                              148,
+	"L1D1L1D1L2":                       147,
+	"L12D4":                            146,
+	"D5L4":                             140,
+	"L4D2L2":                           139,
+	"L1D2L5":                           137,
+	"L3D3L1":                           136,
+	"L2D1L1D1L3":                       133,
+	"D1L2D1L2D1L2":                     132,
+	"L1D1L3D1":                         132,
+	"L2D4L2":                           132,
+	"D1S1D1S1D1S1D3":                   131,
+	"D1L2D1L2":                         130,
+	"L6D3L4":                           130,
+	"L1D1L1D1L1D1L2":                   126,
+	"D1L1D1L1D1L1D1L1D1":               125,
+	"L3D2L1":                           122,
+	"L1D1L5":                           120,
+	"D8L2":                             119,
+	"D9L2":                             118,
+	"D1L1D1L3D1L1":                     115,
+	"D1L2D2L1":                         115,
+	"L11D1":                            114,
+	"D1L3D1L2":                         111,
+	"L4D6":                             110,
+	"L1D1L1D1L1D1L1":                   109,
+	"L1D4L1":                           109,
+	"L1D1L2D1L3":                       108,
+	"L3S1L3":                           108,
+	"D1L5D1":                           107,
+	"L1S2L1":                           105,
+	"L1D1L3D1L2":                       103,
+	"L1S1L5":                           102,
+	"L1D1L1D1":                         99,
+	"D1L3D1L3D1L1":                     98,
+	"D2L3":                             98,
+	"D7L1D2":                           94,
+	"L1D1L2D1L1":                       94,
+	"L5D6":                             92,
+	"L10D4":                            91,
+	"D1L1D1L5":                         89,
+	"L15":                              89,
+	"L1D2L2D1":                         89,
+	"D1L8":                             85,
+	"D7L2":                             85,
+	"D1L2D1L1D1L1D1":                   84,
+	"L2D2L1D1":                         84,
+	"L7S1":                             82,
+	"D5L6":                             81,
+	"L1D4":                             81,
+	"D1L6D3":                           80,
+	"L2S1L3":                           80,
+	"D2L1D1L2D1L2":                     79,
+	"L1D2L1D2":                         78,
+	"L2D9":                             77,
+	"S1L5":                             77,
+	"D4L1":                             76,
+	"L9D2":                             76,
+	"L1D2L1D1L1":                       74,
+	"L5D1L1D1":                         74,
+	"L5S1D3":                           74,
+	"L8D4":                             74,
+	"L1D1L1D1L1":                       73,
+	"L1S1L3D1L2":                       73,
+	"L1S1L4":                           73,
+	"D10L2":                            72,
+	"D1L3D1":                           71,
+	"L11D3":                            71,
+	"L2D3L1":                           71,
+	"L3D1L1D1L2":                       70,
+	"L4D2L2D4":                         70,
+	"D1L4D1L2":                         69,
+	"D2L3D1":                           69,
+	"L5D4L1":                           69,
+	"D1L6D2L3":                         63,
+	"L1D1L3D1L1D1":                     63,
+	"L5S1L2":                           63,
+	"D1L2D2L1D1":                       61,
+	"D2L2D1L1":                         61,
+	"L1D5L1":                           60,
+	"D1L2D2L2D1":                       59,
+	"D1L3S1L3":                         59,
+	"L3D1L1":                           59,
+	"D10L3":                            57,
+	"D18":                              57,
+	"L4D2L1":                           57,
+	"L6D1L3":                           57,
+	"L1D10":                            56,
+	"D3L3D2":                           55,
+	"L3S2L1":                           55,
+	"D2L1D1L2":                         54,
+	"D1L3D1L3D1L3D1L3":                 53,
+	"D2L6D2":                           53,
+	"D1L3D1L2D1L2":                     52,
+	"L1D3":                             52,
+	"D4L6":                             51,
+	"D5S1":                             51,
+	"L1D1L2D1":                         51,
+	"D6S1":                             50,
+	"L10D2":                            50,
+	"L2D3L5":                           50,
+	"L1D1L2D2L1":                       49,
+	"L1D1L2D1L1D1L1D1L1":               48,
+	"L1D1L3D2L1":                       48,
+	"L1D4L2":                           48,
+	"L2D1L1D2":                         47,
+	"L1D2L5S1":                         46,
+	"D4L1D1":                           44,
+	"L8S1":                             44,
+	"D1L1D1L1D1":                       43,
+	"L1D2L2D1L1":                       43,
+	"L1D2L4D1L1":                       43,
+	"L1D3L4":                           43,
+	"D1L6D1L1":                         42,
+	"D2L1D1L1D1L1D2":                   42,
+	"D2L2":                             42,
+	"L17":                              42,
+	"L1D3L1D1L2":                       42,
+	"L2D3L2D1L1D1L1D1L2D1":             42,
+	"L3D3L1D1":                         42,
+	"L4D1L1D1L1":                       42,
+	"S1L3D1L3":                         42,
+	"D3L5D3":                           41,
+	"L1D1L1D1L4":                       41,
+	"L2D4L3":                           41,
+	"D1L3D2L4":                         40,
+	"L1D1L2":                           40,
+	"L1S1L3":                           40,
+	"D11L1":                            39,
+	"D1L4D1L4":                         39,
+	"D2L1D2L1D2L1":                     39,
+	"L6D1L2":                           39,
+	"D4L5":                             38,
+	"L1D1L2D2":                         38,
+	"L1D2L4":                           38,
+	"L1D2L6":                           38,
+	"D15":                              37,
+	"D1L5D2":                           37,
+	"D2L7":                             37,
+	"L1D1L1D1L1D1L1D1L1D1L1D1L1D1":     37,
+	"L2D1L2D1L4":                       37,
+	"L3D1L3D1L3D1":                     37,
+	"L3D2L2":                           37,
+	"L4D8":                             37,
+	"D1L1D1L1D1L1D1":                   36,
+	"L10D3":                            36,
+	"L1D1L1D2L2":                       36,
+	"L1D1L4D1L1D1L1":                   36,
+	"L5D8":                             36,
+	"D5L10":                            35,
+	"D6L4":                             35,
+	"D9L6":                             35,
+	"L2D1L1D1":                         35,
+	"L2D2":                             35,
+	"L3D1L1D1L1":                       35,
+	"S2L6":                             35,
+	"D14":                              34,
+	"D4L2D1L1":                         34,
+	"L6S1":                             34,
+	"L5D3L2":                           33,
+	"D4L1D6":                           32,
+	"L1D1L1D1L1D1L1D1L1":               32,
+	"L1D2L4D4":                         32,
+	"L1D7L1":                           32,
+	"L4D1L1D1":                         32,
+	"S4L2":                             32,
+	"D1L9":                             31,
+	"D20":                              31,
+	"D8L3":                             31,
+	"L1D1L1D2L1D1":                     31,
+	"L1D1L3D3":                         31,
+	"L5S1":                             31,
+	"D2L8":                             30,
+	"D4S1D2S1":                         30,
+	"L4D10":                            30,
+	"L4S1L3":                           30,
+	"S1L5S1":                           30,
+	"L2D1L3D1":                         29,
+	"D2L1D1L1D3":                       28,
+	"D2L1D2L1":                         28,
+	"D9S1":                             28,
+	"L2D1L2D1L2":                       28,
+	"L2D1L2D1L2D1":                     28,
+	"L2D1L3D2":                         28,
+	"L5D1L4":                           28,
+	"L6D9":                             28,
+	"D10L4":                            27,
+	"D1L1D2L1D1L2":                     27,
+	"D2L1D1L1D1L2":                     27,
+	"L3S1L4":                           27,
+	"D1L2D1L2D1L1":                     26,
+	"D1L4D2L3":                         26,
+	"D2L1D1L3":                         26,
+	"D3L3D1":                           26,
+	"D3L3D3L3":                         26,
+	"D4L8":                             26,
+	"L1D1L3D2L1D2":                     26,
+	"L2D4L1":                           26,
+	"L3D1L2D2":                         26,
+	"L3D1L5":                           26,
+	"L4D3L1":                           26,
+	"D1L1D4":                           25,
+	"D2L1D3L2":                         25,
+	"D2L4D1L1":                         25,
+	"L9D4":                             25,
+	"S1L6":                             25,
+	"D1L2D1L2D1L2D1L2":                 24,
+	"D1S1L3":                           24,
+	"D1S1L4":                           24,
+	"L12D6":                            24,
+	"L1D1L1D2L1":                       24,
+	"L1D2L1D3":                         24,
+	"L2D3L1D1L1":                       24,
+	"D17":                              23,
+	"D2L3D1L2":                         23,
+	"D7L3":                             23,
+	"L2D1L1D1L2D1":                     23,
+	"L3D1L1D1L1D1L1D1":                 23,
+	"L5D1L1D3":                         23,
+	"L1D1L1D1L1D1L1D1L1D1L1D1L1D1L1D1": 22,
+	"L1D4L1D1":                         22,
+	"L3S1D4":                           22,
+	"L4D2L2D3":                         22,
+	"L5D1L2D1":                         22,
+	"L7D5":                             22,
+	"L8D1L6":                           22,
+	"L9D7":                             22,
+	"D1L2D1L1D1L2":                     21,
+	"D1L4D2":                           21,
+	"D1L6D2L6D1":                       21,
+	"D2L2D2L2D2L2":                     21,
+	"L2D2L1D3":                         21,
+	"L3D1L1D2":                         21,
+	"L6D2L1":                           21,
+	"S1L8":                             21,
+	"D1L1D1L2D3":                       20,
+	"D2L1D1L2D2":                       20,
+	"D3L1D2L1D1":                       20,
+	"L1D2L1":                           20,
+	"L1D2L1D4":                         20,
+	"L1D2L4D1L2":                       20,
+	"L2D1L1D1L1D1L1":                   20,
+	"L3D1L1D1L6":                       20,
+	"L4D1L3D1L1":                       20,
+	"D1L1D3":                           19,
+	"D4L3D1":                           19,
+	"D8L5":                             19,
+	"L1D1L2D1L5":                       19,
+	"L1D3L2":                           19,
+	"L2D1L2":                           19,
+	"L2D8L2D2":                         19,
+	"L3D9":                             19,
+	"D1L10":                            18,
+	"D1L4D2L2":                         18,
+	"D2L1D1L2D2L1D2L1":                 18,
+	"L11D1L8":                          18,
+	"L1D2L2D1L2":                       18,
+	"L2D1L1D2L2":                       18,
+	"L2D4L4":                           18,
+	"L4S1D2":                           18,
+	"L5S1L3":                           18,
+	"L6D3L1":                           18,
+	"S4L3":                             18,
+	"D2L8D2":                           17,
+	"D4L7":                             17,
+	"L1D6L1S1L1D1L1":                   17,
+	"L2S1L1S1L3":                       17,
+	"L2S1L5":                           17,
+	"L4D1L4D1":                         17,
+	"D1L1D1L4D1L2":                     16,
+	"D1L1D2L4":                         16,
+	"D1L2D1L4":                         16,
+	"D1L2D2L3":                         16,
+	"D1L4D1L1D1L2":                     16,
+	"D2L3D2":                           16,
+	"D3L1D3L1":                         16,
+	"D6L1D1":                           16,
+	"D6L5":                             16,
+	"L1D1L1D1L2D2L1":                   16,
+	"L1D1L2D4":                         16,
+	"L3D7":                             16,
+	"S1L6D1":                           16,
+	"D11L3":                            15,
+	"D1L1D2L1D1L1D1":                   15,
+	"D1L3D1L1D2L2":                     15,
+	"D2L1D1L7":                         15,
+	"D3L2D2L1":                         15,
+	"D3L8":                             15,
+	"D4L12":                            15,
+	"D7L7":                             15,
+	"L1D2L3D3L1":                       15,
+	"L2S2L1D1L2":                       15,
+	"L3D2L2D1":                         15,
+	"L3S1":                             15,
+	"L6S1D2":                           15,
+	"S1L4":                             15,
+	"D10L6":                            14,
+	"D19":                              14,
+	"D1L1D1L1D1L2":                     14,
+	"D1L1D1L2D1L4":                     14,
+	"D1L3D1L2D1":                       14,
+	"D1L3D3L1":                         14,
+	"D3L5D1":                           14,
+	"D3S1D3":                           14,
+	"D8L6":                             14,
+	"L16":                              14,
+	"L1D1L1D1L1D1L1D1L1D1L1D1L1D1L1D1L1D1L1D1": 14,
+	"L1D1L2D1L2D1":         14,
+	"L1D1L2D2L1D1":         14,
+	"L2D1L4D1":             14,
+	"L3D1L1D1L2D1":         14,
+	"L3D2L1D1":             14,
+	"L4D1L2D1":             14,
+	"L4S1L1":               14,
+	"L5D2L1":               14,
+	"L8D5":                 14,
+	"L9D2L1":               14,
+	"D13":                  13,
+	"D16":                  13,
+	"D1L2D1L2D1L2D1L2D1L2": 13,
+	"D1L7D1":               13,
+	"D2L1D1L4":             13,
+	"D2L1D2L3":             13,
+	"D3L1D2":               13,
+	"D3L3D2L2":             13,
+	"D3L6D3":               13,
+	"D3L7":                 13,
+	"D9L4":                 13,
+	"L10D5":                13,
+	"L1D1L1D1L3D1":         13,
+	"L1D1L2D1L2":           13,
+	"L2D1L1D2L1D1L2":       13,
+	"L2D1L4D1L1":           13,
+	"L2S1L7":               13,
+	"L3D1L2D1L2D1L1":       13,
+	"L3D3L3D3":             13,
+	"L3S1D1L3":             13,
+	"L4D2L1D1":             13,
+	"L4S1L2":               13,
+	"L7D9":                 13,
+	"L8D1L1":               13,
+	"L9S1L2":               13,
+	"S1L1D1S1D2S1L2":       13,
+	"D2L2D3":               12,
+	"D2L4D1L2D2L2":         12,
+	"D4L1D4":               12,
+	"D4L4D4":               12,
+	"L12D5":                12,
+	"L1D1L1D1L1D1L4":       12,
+	"L1D1L2D3L1":           12,
+	"L1D1L4D1L1":           12,
+	"L1D2L1D1L1D1":         12,
+	"L1D3L2D2":             12,
+	"L1S1L6":               12,
+	"L2D1L3D2L1":           12,
+	"L2S1L4":               12,
+	"L3D1L2D1":             12,
+	"L3D2L6D1":             12,
+	"L3D3L4":               12,
+	"L3D8":                 12,
+	"L3S1L2D1L2":           12,
+	"L4D1L1D2L3":           12,
+	"L4D1L5":               12,
+	"L4D3L1D1L2D1":         12,
+	"L4D4S1L3D3":           12,
+	"L4D9":                 12,
+	"L6D1L4":               12,
+	"L6D8":                 12,
+	"L6S1D1":               12,
+	"D1L1D1L1D1L3":         11,
+	"D1L1D2L1D1":           11,
+	"D1L1D2L3D1":           11,
+	"D1L2":                 11,
+	"D1L3D1L3D1":           11,
+	"D1L3D2":               11,
+	"D2L10":                11,
+	"D5L5D1":               11,
+	"D8L4":                 11,
+	"L1D1L2D2L4":           11,
+	"L1D1L4D2L1":           11,
+	"L1D1L5D1":             11,
+	"L1D2L2D2L1":           11,
+	"L1D2L7":               11,
+	"L1D3L3D2":             11,
+	"L1S1L3D1L1":           11,
+	"L2D1L1D1L2D1L2":       11,
+	"L2D2L1":               11,
+	"L2D2L1D1L1":           11,
+	"L2D3L2D1":             11,
+	"L2D3L2D1L1D1L1D1L3":   11,
+	"L2D6L1":               11,
+	"L2D7":                 11,
+	"L2D8":                 11,
+	"L2S1L1S1L3S1L6":       11,
+	"L3D1L4D2":             11,
+	"L3D2L2D2L2D3":         11,
+	"L3S1L6":               11,
+	"L4D1L1D1L3D1L6":       11,
+	"L5D3L1":               11,
+	"L5D9":                 11,
+	"L6D1L5":               11,
+	"L6S1L1":               11,
+	"L7D1L1D1":             11,
+	"L7D2L2":               11,
+	"L8D2L2":               11,
+	"S1L4S1":               11,
+}
@@ -0,0 +1,9 @@
+package frequency // generated by build_wordlists.py
+
+// Wordlists are the word lists of passphrase generators: the original diceware
+// list and the EFF large and short lists. Words are in dice roll order.
+var Wordlists = map[string][]string{
+	"diceware":  {"a", "a&p", "a's", "aa", "aaa", "aaaa", "aaron", "ab", "aba", "ababa", "aback", "abase", "abash", "abate", "abbas", "abbe", "abbey", "abbot", "abbott", "abc", "abe", "abed", "abel", "abet", "abide", "abject", "ablaze", "able", "abner", "abo", "abode", "abort", "about", "above", "abrade", "abram", "absorb", "abuse", "abut", "abyss", "ac", "acadia", "accra", "accrue", "ace", "acetic", "ache", "acid", "acidic", "acm", "acme", "acorn", "acre", "acrid", "act", "acton", "actor", "acts", "acuity", "acute", "ad", "ada", "adage", "adagio", "adair", "adam", "adams", "adapt", "add", "added", "addict", "addis", "addle", "adele", "aden", "adept", "adieu", "adjust", "adler", "admit", "admix", "ado", "adobe", "adonis", "adopt", "adore", "adorn", "adult", "advent", "advert", "advise", "ae", "aegis", "aeneid", "af", "afar", "affair", "affine", "affix", "afire", "afoot", "afraid", "africa", "afro", "aft", "ag", "again", "agate", "agave", "age", "agee", "agenda", "agent", "agile", "aging", "agnes", "agnew", "ago", "agone", "agony", "agree", "ague", "agway", "ah", "ahead", "ahem", "ahoy", "ai", "aid", "aida", "aide", "aides", "aiken", "ail", "aile", "aim", "ain't", "ainu", "air", "aires", "airman", "airway", "airy", "aisle", "aj", "ajar", "ajax", "ak", "akers", "akin", "akron", "al", "ala", "alai", "alamo", "alan", "alarm", "alaska", "alb", "alba", "album", "alcoa", "alden", "alder", "ale", "alec", "aleck", "aleph", "alert", "alex", "alexei", "alga", "algae", "algal", "alger", "algol", "ali", "alia", "alias", "alibi", "alice", "alien", "alight", "align", "alike", "alive", "all", "allah", "allan", "allay", "allen", "alley", "allied", "allis", "allot", "allow", "alloy", "allure", "ally", "allyl", "allyn", "alma", "almost", "aloe", "aloft", "aloha", "alone", "along", "aloof", "aloud", "alp", "alpha", "alps", "also", "alsop", "altair", "altar", "alter", "alto", "alton", "alum", "alumni", "alva", "alvin", "alway", "am", "ama", "amass", "amaze", "amber", "amble", "ambush", "amen", "amend", "ames", "ami", "amid", "amide", "amigo", "amino", "amiss", "amity", "amman", "ammo", "amoco", "amok", "among", "amort", "amos", "amp", "ampere", "ampex", "ample", "amply", "amra", "amulet", "amuse", "amy", "an", "ana", "and", "andes", "andre", "andrew", "andy", "anent", "anew", "angel", "angelo", "anger", "angie", "angle", "anglo", "angola", "angry", "angst", "angus", "ani", "anion", "anise", "anita", "ankle", "ann", "anna", "annal", "anne", "annex", "annie", "annoy", "annul", "annuli", "annum", "anode", "ansi", "answer", "ant", "ante", "anti", "antic", "anton", "anus", "anvil", "any", "anyhow", "anyway", "ao", "aok", "aorta", "ap", "apart", "apathy", "ape", "apex", "aphid", "aplomb", "appeal", "append", "apple", "apply", "april", "apron", "apse", "apt", "aq", "aqua", "ar", "arab", "araby", "arc", "arcana", "arch", "archer", "arden", "ardent", "are", "area", "arena", "ares", "argive", "argo", "argon", "argot", "argue", "argus", "arhat", "arid", "aries", "arise", "ark", "arlen", "arlene", "arm", "armco", "army", "arnold", "aroma", "arose", "arpa", "array", "arrear", "arrow", "arson", "art", "artery", "arthur", "artie", "arty", "aruba", "arum", "aryl", "as", "ascend", "ash", "ashen", "asher", "ashley", "ashy", "asia", "aside", "ask", "askew", "asleep", "aspen", "aspire", "ass", "assai", "assam", "assay", "asset", "assort", "assure", "aster", "astm", "astor", "astral", "at", "at&t", "ate", "athens", "atlas", "atom", "atomic", "atone", "atop", "attic", "attire", "au", "aubrey", "audio", "audit", "aug", "auger", "augur", "august", "auk", "aunt", "aura", "aural", "auric", "austin", "auto", "autumn", "av", "avail", "ave", "aver", "avert", "avery", "aviate", "avid", "avis", "aviv", "avoid", "avon", "avow", "aw", "await", "awake", "award", "aware", "awash", "away", "awe", "awful", "awl", "awn", "awoke", "awry", "ax", "axe", "axes", "axial", "axiom", "axis", "axle", "axon", "ay", "aye", "ayers", "az", "aztec", "azure", "b", "b's", "ba", "babe", "babel", "baby", "bach", "back", "backup", "bacon", "bad", "bade", "baden", "badge", "baffle", "bag", "baggy", "bah", "bahama", "bail", "baird", "bait", "bake", "baku", "bald", "baldy", "bale", "bali", "balk", "balkan", "balky", "ball", "balled", "ballot", "balm", "balmy", "balsa", "bam", "bambi", "ban", "banal", "band", "bandit", "bandy", "bane", "bang", "banish", "banjo", "bank", "banks", "bantu", "bar", "barb", "bard", "bare", "barfly", "barge", "bark", "barley", "barn", "barnes", "baron", "barony", "barr", "barre", "barry", "barter", "barth", "barton", "basal", "base", "basel", "bash", "basic", "basil", "basin", "basis", "bask", "bass", "bassi", "basso", "baste", "bat", "batch", "bate", "bater", "bates", "bath", "bathe", "batik", "baton", "bator", "batt", "bauble", "baud", "bauer", "bawd", "bawdy", "bawl", "baxter", "bay", "bayda", "bayed", "bayou", "bazaar", "bb", "bbb", "bbbb", "bc", "bcd", "bd", "be", "beach", "bead", "beady", "beak", "beam", "bean", "bear", "beard", "beast", "beat", "beau", "beauty", "beaux", "bebop", "becalm", "beck", "becker", "becky", "bed", "bedim", "bee", "beebe", "beech", "beef", "beefy", "been", "beep", "beer", "beet", "befall", "befit", "befog", "beg", "began", "beget", "beggar", "begin", "begun", "behind", "beige", "being", "beirut", "bel", "bela", "belch", "belfry", "belie", "bell", "bella", "belle", "belly", "below", "belt", "bema", "beman", "bemoan", "ben", "bench", "bend", "bender", "benny", "bent", "benz", "berea", "bereft", "beret", "berg", "berlin", "bern", "berne", "bernet", "berra", "berry", "bert", "berth", "beryl", "beset", "bess", "bessel", "best", "bestir", "bet", "beta", "betel", "beth", "bethel", "betsy", "bette", "betty", "bevel", "bevy", "beware", "bey", "bezel", "bf", "bg", "bh", "bhoy", "bi", "bias", "bib", "bibb", "bible", "bicep", "biceps", "bid", "biddy", "bide", "bien", "big", "biggs", "bigot", "bile", "bilge", "bilk", "bill", "billow", "billy", "bin", "binary", "bind", "bing", "binge", "bingle", "bini", "biota", "birch", "bird", "birdie", "birth", "bison", "bisque", "bit", "bitch", "bite", "bitt", "bitten", "biz", "bizet", "bj", "bk", "bl", "blab", "black", "blade", "blair", "blake", "blame", "blanc", "bland", "blank", "blare", "blast", "blat", "blatz", "blaze", "bleak", "bleat", "bled", "bleed", "blend", "bless", "blest", "blew", "blimp", "blind", "blink", "blinn", "blip", "bliss", "blithe", "blitz", "bloat", "blob", "bloc", "bloch", "block", "bloke", "blond", "blonde", "blood", "bloom", "bloop", "blot", "blotch", "blow", "blown", "blue", "bluet", "bluff", "blum", "blunt", "blur", "blurt", "blush", "blvd", "blythe", "bm", "bmw", "bn", "bo", "boa", "boar", "board", "boast", "boat", "bob", "bobbin", "bobby", "bobcat", "boca", "bock", "bode", "body", "bog", "bogey", "boggy", "bogus", "bogy", "bohr", "boil", "bois", "boise", "bold", "bole", "bolo", "bolt", "bomb", "bombay", "bon", "bona", "bond", "bone", "bong", "bongo", "bonn", "bonus", "bony", "bonze", "boo", "booby", "boogie", "book", "booky", "boom", "boon", "boone", "boor", "boost", "boot", "booth", "booty", "booze", "bop", "borax", "border", "bore", "borg", "boric", "boris", "born", "borne", "borneo", "boron", "bosch", "bose", "bosom", "boson", "boss", "boston", "botch", "both", "bottle", "bough", "bouncy", "bound", "bourn", "bout", "bovine", "bow", "bowel", "bowen", "bowie", "bowl", "box", "boxy", "boy", "boyar", "boyce", "boyd", "boyle", "bp", "bq", "br", "brace", "bract", "brad", "brady", "brae", "brag", "bragg", "braid", "brain", "brainy", "brake", "bran", "brand", "brandt", "brant", "brash", "brass", "brassy", "braun", "brave", "bravo", "brawl", "bray", "bread", "break", "bream", "breath", "bred", "breed", "breeze", "bremen", "brent", "brest", "brett", "breve", "brew", "brian", "briar", "bribe", "brice", "brick", "bride", "brief", "brig", "briggs", "brim", "brine", "bring", "brink", "briny", "brisk", "broad", "brock", "broil", "broke", "broken", "bronx", "brood", "brook", "brooke", "broom", "broth", "brow", "brown", "browse", "bruce", "bruit", "brunch", "bruno", "brunt", "brush", "brute", "bryan", "bryant", "bryce", "bryn", "bs", "bstj", "bt", "btl", "bu", "bub", "buck", "bud", "budd", "buddy", "budge", "buena", "buenos", "buff", "bug", "buggy", "bugle", "buick", "build", "built", "bulb", "bulge", "bulk", "bulky", "bull", "bully", "bum", "bump", "bun", "bunch", "bundy", "bunk", "bunny", "bunt", "bunyan", "buoy", "burch", "bureau", "buret", "burg", "buried", "burke", "burl", "burly", "burma", "burn", "burnt", "burp", "burr", "burro", "burst", "burt", "burton", "burtt", "bury", "bus", "busch", "bush", "bushel", "bushy", "buss", "bust", "busy", "but", "butane", "butch", "buteo", "butt", "butte", "butyl", "buxom", "buy", "buyer", "buzz", "buzzy", "bv", "bw", "bx", "by", "bye", "byers", "bylaw", "byline", "byrd", "byrne", "byron", "byte", "byway", "byword", "bz", "c", "c's", "ca", "cab", "cabal", "cabin", "cable", "cabot", "cacao", "cache", "cacm", "cacti", "caddy", "cadent", "cadet", "cadre", "cady", "cafe", "cage", "cagey", "cahill", "caiman", "cain", "caine", "cairn", "cairo", "cake", "cal", "calder", "caleb", "calf", "call", "calla", "callus", "calm", "calve", "cam", "camber", "came", "camel", "cameo", "camp", "can", "can't", "canal", "canary", "cancer", "candle", "candy", "cane", "canis", "canna", "cannot", "canny", "canoe", "canon", "canopy", "cant", "canto", "canton", "cap", "cape", "caper", "capo", "car", "carbon", "card", "care", "caress", "caret", "carey", "cargo", "carib", "carl", "carla", "carlo", "carne", "carob", "carol", "carp", "carpet", "carr", "carrie", "carry", "carson", "cart", "carte", "caruso", "carve", "case", "casey", "cash", "cashew", "cask", "casket", "cast", "caste", "cat", "catch", "cater", "cathy", "catkin", "catsup", "cauchy", "caulk", "cause", "cave", "cavern", "cavil", "cavort", "caw", "cayuga", "cb", "cbs", "cc", "ccc", "cccc", "cd", "cdc", "ce", "cease", "cecil", "cedar", "cede", "ceil", "celia", "cell", "census", "cent", "ceres", "cern", "cetera", "cetus", "cf", "cg", "ch", "chad", "chafe", "chaff", "chai", "chain", "chair", "chalk", "champ", "chance", "chang", "chant", "chao", "chaos", "chap", "chapel", "char", "chard", "charm", "chart", "chase", "chasm", "chaste", "chat", "chaw", "cheap", "cheat", "check", "cheek", "cheeky", "cheer", "chef", "chen", "chert", "cherub", "chess", "chest", "chevy", "chew", "chi", "chic", "chick", "chide", "chief", "child", "chile", "chili", "chill", "chilly", "chime", "chin", "china", "chine", "chink", "chip", "chirp", "chisel", "chit", "chive", "chock", "choir", "choke", "chomp", "chop", "chopin", "choral", "chord", "chore", "chose", "chosen", "chou", "chow", "chris", "chub", "chuck", "chuff", "chug", "chum", "chump", "chunk", "churn", "chute", "ci", "cia", "cicada", "cider", "cigar", "cilia", "cinch", "cindy", "cipher", "circa", "circe", "cite", "citrus", "city", "civet", "civic", "civil", "cj", "ck", "cl", "clad", "claim", "clam", "clammy", "clamp", "clan", "clang", "clank", "clap", "clara", "clare", "clark", "clarke", "clash", "clasp", "class", "claus", "clause", "claw", "clay", "clean", "clear", "cleat", "cleft", "clerk", "cliche", "click", "cliff", "climb", "clime", "cling", "clink", "clint", "clio", "clip", "clive", "cloak", "clock", "clod", "clog", "clomp", "clone", "close", "closet", "clot", "cloth", "cloud", "clout", "clove", "clown", "cloy", "club", "cluck", "clue", "cluj", "clump", "clumsy", "clung", "clyde", "cm", "cn", "co", "coach", "coal", "coast", "coat", "coax", "cobb", "cobble", "cobol", "cobra", "coca", "cock", "cockle", "cocky", "coco", "cocoa", "cod", "coda", "coddle", "code", "codon", "cody", "coed", "cog", "cogent", "cohen", "cohn", "coil", "coin", "coke", "col", "cola", "colby", "cold", "cole", "colon", "colony", "colt", "colza", "coma", "comb", "combat", "come", "comet", "cometh", "comic", "comma", "con", "conch", "cone", "coney", "congo", "conic", "conn", "conner", "conway", "cony", "coo", "cook", "cooke", "cooky", "cool", "cooley", "coon", "coop", "coors", "coot", "cop", "cope", "copra", "copy", "coral", "corbel", "cord", "core", "corey", "cork", "corn", "corny", "corp", "corps", "corvus", "cos", "cosec", "coset", "cosh", "cost", "costa", "cosy", "cot", "cotta", "cotty", "couch", "cough", "could", "count", "coup", "coupe", "court", "cousin", "cove", "coven", "cover", "covet", "cow", "cowan", "cowl", "cowman", "cowry", "cox", "coy", "coyote", "coypu", "cozen", "cozy", "cp", "cpa", "cq", "cr", "crab", "crack", "craft", "crag", "craig", "cram", "cramp", "crane", "crank", "crap", "crash", "crass", "crate", "crater", "crave", "craw", "crawl", "craze", "crazy", "creak", "cream", "credit", "credo", "creed", "creek", "creep", "creole", "creon", "crepe", "crept", "cress", "crest", "crete", "crew", "crib", "cried", "crime", "crimp", "crisp", "criss", "croak", "crock", "crocus", "croft", "croix", "crone", "crony", "crook", "croon", "crop", "cross", "crow", "crowd", "crown", "crt", "crud", "crude", "cruel", "crumb", "crump", "crush", "crust", "crux", "cruz", "cry", "crypt", "cs", "ct", "cu", "cub", "cuba", "cube", "cubic", "cud", "cuddle", "cue", "cuff", "cull", "culpa", "cult", "cumin", "cuny", "cup", "cupful", "cupid", "cur", "curb", "curd", "cure", "curfew", "curia", "curie", "curio", "curl", "curry", "curse", "curt", "curve", "cusp", "cut", "cute", "cutlet", "cv", "cw", "cx", "cy", "cycad", "cycle", "cynic", "cyril", "cyrus", "cyst", "cz", "czar", "czech", "d", "d'art", "d's", "da", "dab", "dacca", "dactyl", "dad", "dada", "daddy", "dade", "daffy", "dahl", "dahlia", "dairy", "dais", "daisy", "dakar", "dale", "daley", "dally", "daly", "dam", "dame", "damn", "damon", "damp", "damsel", "dan", "dana", "dance", "dandy", "dane", "dang", "dank", "danny", "dante", "dar", "dare", "dark", "darken", "darn", "darry", "dart", "dash", "data", "date", "dater", "datum", "daub", "daunt", "dave", "david", "davis", "davit", "davy", "dawn", "dawson", "day", "daze", "db", "dc", "dd", "ddd", "dddd", "de", "deacon", "dead", "deaf", "deal", "dealt", "dean", "deane", "dear", "death", "debar", "debby", "debit", "debra", "debris", "debt", "debug", "debut", "dec", "decal", "decay", "decca", "deck", "decker", "decor", "decree", "decry", "dee", "deed", "deem", "deep", "deer", "deere", "def", "defer", "deform", "deft", "defy", "degas", "degum", "deify", "deign", "deity", "deja", "del", "delay", "delft", "delhi", "delia", "dell", "della", "delta", "delve", "demark", "demit", "demon", "demur", "den", "deneb", "denial", "denny", "dense", "dent", "denton", "deny", "depot", "depth", "depute", "derby", "derek", "des", "desist", "desk", "detach", "deter", "deuce", "deus", "devil", "devoid", "devon", "dew", "dewar", "dewey", "dewy", "dey", "df", "dg", "dh", "dhabi", "di", "dial", "diana", "diane", "diary", "dibble", "dice", "dick", "dicta", "did", "dido", "die", "died", "diego", "diem", "diesel", "diet", "diety", "dietz", "dig", "digit", "dilate", "dill", "dim", "dime", "din", "dinah", "dine", "ding", "dingo", "dingy", "dint", "diode", "dip", "dirac", "dire", "dirge", "dirt", "dirty", "dis", "disc", "dish", "disk", "disney", "ditch", "ditto", "ditty", "diva", "divan", "dive", "dixie", "dixon", "dizzy", "dj", "dk", "dl", "dm", "dn", "dna", "do", "dobbs", "dobson", "dock", "docket", "dod", "dodd", "dodge", "dodo", "doe", "doff", "dog", "doge", "dogma", "dolan", "dolce", "dole", "doll", "dolly", "dolt", "dome", "don", "don't", "done", "doneck", "donna", "donor", "doom", "door", "dope", "dora", "doria", "doric", "doris", "dose", "dot", "dote", "double", "doubt", "douce", "doug", "dough", "dour", "douse", "dove", "dow", "dowel", "down", "downs", "dowry", "doyle", "doze", "dozen", "dp", "dq", "dr", "drab", "draco", "draft", "drag", "drain", "drake", "dram", "drama", "drank", "drape", "draw", "drawl", "drawn", "dread", "dream", "dreamy", "dreg", "dress", "dressy", "drew", "drib", "dried", "drier", "drift", "drill", "drink", "drip", "drive", "droll", "drone", "drool", "droop", "drop", "dross", "drove", "drown", "drub", "drug", "druid", "drum", "drunk", "drury", "dry", "dryad", "ds", "dt", "du", "dual", "duane", "dub", "dubhe", "dublin", "ducat", "duck", "duct", "dud", "due", "duel", "duet", "duff", "duffy", "dug", "dugan", "duke", "dull", "dully", "dulse", "duly", "duma", "dumb", "dummy", "dump", "dumpy", "dun", "dunce", "dune", "dung", "dunham", "dunk", "dunlop", "dunn", "dupe", "durer", "dusk", "dusky", "dust", "dusty", "dutch", "duty", "dv", "dw", "dwarf", "dwell", "dwelt", "dwight", "dwyer", "dx", "dy", "dyad", "dye", "dyer", "dying", "dyke", "dylan", "dyne", "dz", "e", "e'er", "e's", "ea", "each", "eagan", "eager", "eagle", "ear", "earl", "earn", "earth", "ease", "easel", "east", "easy", "eat", "eaten", "eater", "eaton", "eave", "eb", "ebb", "eben", "ebony", "ec", "echo", "eclat", "ecole", "ed", "eddie", "eddy", "eden", "edgar", "edge", "edgy", "edict", "edify", "edit", "edith", "editor", "edna", "edt", "edwin", "ee", "eee", "eeee", "eel", "eeoc", "eerie", "ef", "efface", "effie", "efg", "eft", "eg", "egan", "egg", "ego", "egress", "egret", "egypt", "eh", "ei", "eider", "eight", "eire", "ej", "eject", "ek", "eke", "el", "elan", "elate", "elba", "elbow", "elder", "eldon", "elect", "elegy", "elena", "eleven", "elfin", "elgin", "eli", "elide", "eliot", "elite", "elk", "ell", "ella", "ellen", "ellis", "elm", "elmer", "elope", "else", "elsie", "elton", "elude", "elute", "elves", "ely", "em", "embalm", "embark", "embed", "ember", "emcee", "emery", "emil", "emile", "emily", "emit", "emma", "emory", "empty", "en", "enact", "enamel", "end", "endow", "enemy", "eng", "engel", "engle", "engulf", "enid", "enjoy", "enmity", "enoch", "enol", "enos", "enrico", "ensue", "enter", "entrap", "entry", "envoy", "envy", "eo", "ep", "epa", "epic", "epoch", "epoxy", "epsom", "eq", "equal", "equip", "er", "era", "erase", "erato", "erda", "ere", "erect", "erg", "eric", "erich", "erie", "erik", "ernest", "ernie", "ernst", "erode", "eros", "err", "errand", "errol", "error", "erupt", "ervin", "erwin", "es", "essay", "essen", "essex", "est", "ester", "estes", "estop", "et", "eta", "etc", "etch", "ethan", "ethel", "ether", "ethic", "ethos", "ethyl", "etude", "eu", "eucre", "euler", "eureka", "ev", "eva", "evade", "evans", "eve", "even", "event", "every", "evict", "evil", "evoke", "evolve", "ew", "ewe", "ewing", "ex", "exact", "exalt", "exam", "excel", "excess", "exert", "exile", "exist", "exit", "exodus", "expel", "extant", "extent", "extol", "extra", "exude", "exult", "exxon", "ey", "eye", "eyed", "ez", "ezra", "f", "f's", "fa", "faa", "faber", "fable", "face", "facet", "facile", "fact", "facto", "fad", "fade", "faery", "fag", "fahey", "fail", "fain", "faint", "fair", "fairy", "faith", "fake", "fall", "false", "fame", "fan", "fancy", "fang", "fanny", "fanout", "far", "farad", "farce", "fare", "fargo", "farley", "farm", "faro", "fast", "fat", "fatal", "fate", "fatty", "fault", "faun", "fauna", "faust", "fawn", "fay", "faze", "fb", "fbi", "fc", "fcc", "fd", "fda", "fe", "fear", "feast", "feat", "feb", "fed", "fee", "feed", "feel", "feet", "feign", "feint", "felice", "felix", "fell", "felon", "felt", "femur", "fence", "fend", "fermi", "fern", "ferric", "ferry", "fest", "fetal", "fetch", "fete", "fetid", "fetus", "feud", "fever", "few", "ff", "fff", "ffff", "fg", "fgh", "fh", "fi", "fiat", "fib", "fibrin", "fiche", "fide", "fief", "field", "fiend", "fiery", "fife", "fifo", "fifth", "fifty", "fig", "fight", "filch", "file", "filet", "fill", "filler", "filly", "film", "filmy", "filth", "fin", "final", "finale", "finch", "find", "fine", "finite", "fink", "finn", "finny", "fir", "fire", "firm", "first", "fish", "fishy", "fisk", "fiske", "fist", "fit", "fitch", "five", "fix", "fj", "fjord", "fk", "fl", "flack", "flag", "flail", "flair", "flak", "flake", "flaky", "flam", "flame", "flank", "flap", "flare", "flash", "flask", "flat", "flatus", "flaw", "flax", "flea", "fleck", "fled", "flee", "fleet", "flesh", "flew", "flex", "flick", "flier", "flinch", "fling", "flint", "flip", "flirt", "flit", "flo", "float", "floc", "flock", "floe", "flog", "flood", "floor", "flop", "floppy", "flora", "flour", "flout", "flow", "flown", "floyd", "flu", "flub", "flue", "fluff", "fluid", "fluke", "flung", "flush", "flute", "flux", "fly", "flyer", "flynn", "fm", "fmc", "fn", "fo", "foal", "foam", "foamy", "fob", "focal", "foci", "focus", "fodder", "foe", "fog", "foggy", "fogy", "foil", "foist", "fold", "foley", "folio", "folk", "folly", "fond", "font", "food", "fool", "foot", "foote", "fop", "for", "foray", "force", "ford", "fore", "forge", "forgot", "fork", "form", "fort", "forte", "forth", "forty", "forum", "foss", "fossil", "foul", "found", "fount", "four", "fovea", "fowl", "fox", "foxy", "foyer", "fp", "fpc", "fq", "fr", "frail", "frame", "fran", "franc", "franca", "frank", "franz", "frau", "fraud", "fray", "freak", "fred", "free", "freed", "freer", "frenzy", "freon", "fresh", "fret", "freud", "frey", "freya", "friar", "frick", "fried", "frill", "frilly", "frisky", "fritz", "fro", "frock", "frog", "from", "front", "frost", "froth", "frown", "froze", "fruit", "fry", "frye", "fs", "ft", "ftc", "fu", "fuchs", "fudge", "fuel", "fugal", "fugue", "fuji", "full", "fully", "fum", "fume", "fun", "fund", "fungal", "fungi", "funk", "funny", "fur", "furl", "furry", "fury", "furze", "fuse", "fuss", "fussy", "fusty", "fuzz", "fuzzy", "fv", "fw", "fx", "fy", "fz", "g", "g's", "ga", "gab", "gable", "gabon", "gad", "gadget", "gaff", "gaffe", "gag", "gage", "gail", "gain", "gait", "gal", "gala", "galaxy", "gale", "galen", "gall", "gallop", "galt", "gam", "game", "gamin", "gamma", "gamut", "gander", "gang", "gao", "gap", "gape", "gar", "garb", "garish", "garner", "garry", "garth", "gary", "gas", "gash", "gasp", "gassy", "gate", "gates", "gator", "gauche", "gaudy", "gauge", "gaul", "gaunt", "gaur", "gauss", "gauze", "gave", "gavel", "gavin", "gawk", "gawky", "gay", "gaze", "gb", "gc", "gd", "ge", "gear", "gecko", "gee", "geese", "geigy", "gel", "geld", "gem", "gemma", "gene", "genie", "genii", "genoa", "genre", "gent", "gentry", "genus", "gerbil", "germ", "gerry", "get", "getty", "gf", "gg", "ggg", "gggg", "gh", "ghana", "ghent", "ghetto", "ghi", "ghost", "ghoul", "gi", "giant", "gibbs", "gibby", "gibe", "giddy", "gift", "gig", "gil", "gila", "gild", "giles", "gill", "gilt", "gimbal", "gimpy", "gin", "gina", "ginn", "gino", "gird", "girl", "girth", "gist", "give", "given", "gj", "gk", "gl", "glad", "gladdy", "glade", "glamor", "gland", "glans", "glare", "glass", "glaze", "gleam", "glean", "glee", "glen", "glenn", "glib", "glide", "glint", "gloat", "glob", "globe", "glom", "gloom", "glory", "gloss", "glove", "glow", "glue", "glued", "gluey", "gluing", "glum", "glut", "glyph", "gm", "gmt", "gn", "gnarl", "gnash", "gnat", "gnaw", "gnome", "gnp", "gnu", "go", "goa", "goad", "goal", "goat", "gob", "goer", "goes", "goff", "gog", "goggle", "gogh", "gogo", "gold", "golf", "golly", "gone", "gong", "goo", "good", "goode", "goody", "goof", "goofy", "goose", "gop", "gordon", "gore", "goren", "gorge", "gorky", "gorse", "gory", "gosh", "gospel", "got", "gouda", "gouge", "gould", "gourd", "gout", "gown", "gp", "gpo", "gq", "gr", "grab", "grace", "grad", "grade", "grady", "graff", "graft", "grail", "grain", "grand", "grant", "grape", "graph", "grasp", "grass", "grata", "grate", "grater", "grave", "gravy", "gray", "graze", "great", "grebe", "greed", "greedy", "greek", "green", "greer", "greet", "greg", "gregg", "greta", "grew", "grey", "grid", "grief", "grieve", "grill", "grim", "grime", "grimm", "grin", "grind", "grip", "gripe", "grist", "grit", "groan", "groat", "groin", "groom", "grope", "gross", "groton", "group", "grout", "grove", "grow", "growl", "grown", "grub", "gruff", "grunt", "gs", "gsa", "gt", "gu", "guam", "guano", "guard", "guess", "guest", "guide", "guild", "guile", "guilt", "guise", "guitar", "gules", "gulf", "gull", "gully", "gulp", "gum", "gumbo", "gummy", "gun", "gunk", "gunky", "gunny", "gurgle", "guru", "gus", "gush", "gust", "gusto", "gusty", "gut", "gutsy", "guy", "guyana", "gv", "gw", "gwen", "gwyn", "gx", "gy", "gym", "gyp", "gypsy", "gyro", "gz", "h", "h's", "ha", "haag", "haas", "habib", "habit", "hack", "had", "hades", "hadron", "hagen", "hager", "hague", "hahn", "haifa", "haiku", "hail", "hair", "hairy", "haiti", "hal", "hale", "haley", "half", "hall", "halma", "halo", "halt", "halvah", "halve", "ham", "hamal", "hamlin", "han", "hand", "handy", "haney", "hang", "hank", "hanna", "hanoi", "hans", "hansel", "hap", "happy", "hard", "hardy", "hare", "harem", "hark", "harley", "harm", "harp", "harpy", "harry", "harsh", "hart", "harvey", "hash", "hasp", "hast", "haste", "hasty", "hat", "hatch", "hate", "hater", "hath", "hatred", "haul", "haunt", "have", "haven", "havoc", "haw", "hawk", "hay", "haydn", "hayes", "hays", "hazard", "haze", "hazel", "hazy", "hb", "hc", "hd", "he", "he'd", "he'll", "head", "heady", "heal", "healy", "heap", "hear", "heard", "heart", "heat", "heath", "heave", "heavy", "hebe", "hebrew", "heck", "heckle", "hedge", "heed", "heel", "heft", "hefty", "heigh", "heine", "heinz", "heir", "held", "helen", "helga", "helix", "hell", "hello", "helm", "helmut", "help", "hem", "hemp", "hen", "hence", "henri", "henry", "her", "hera", "herb", "herd", "here", "hero", "heroic", "heron", "herr", "hertz", "hess", "hesse", "hettie", "hetty", "hew", "hewitt", "hewn", "hex", "hey", "hf", "hg", "hh", "hhh", "hhhh", "hi", "hiatt", "hick", "hicks", "hid", "hide", "high", "hij", "hike", "hill", "hilly", "hilt", "hilum", "him", "hind", "hindu", "hines", "hinge", "hint", "hip", "hippo", "hippy", "hiram", "hire", "hirsch", "his", "hiss", "hit", "hitch", "hive", "hj", "hk", "hl", "hm", "hn", "ho", "hoagy", "hoar", "hoard", "hob", "hobbs", "hobby", "hobo", "hoc", "hock", "hodge", "hodges", "hoe", "hoff", "hog", "hogan", "hoi", "hokan", "hold", "holdup", "hole", "holly", "holm", "holst", "holt", "home", "homo", "honda", "hondo", "hone", "honey", "hong", "honk", "hooch", "hood", "hoof", "hook", "hookup", "hoop", "hoot", "hop", "hope", "horde", "horn", "horny", "horse", "horus", "hose", "host", "hot", "hotbox", "hotel", "hough", "hound", "hour", "house", "hove", "hovel", "hover", "how", "howdy", "howe", "howl", "hoy", "hoyt", "hp", "hq", "hr", "hs", "ht", "hu", "hub", "hubbub", "hubby", "huber", "huck", "hue", "hued", "huff", "hug", "huge", "hugh", "hughes", "hugo", "huh", "hulk", "hull", "hum", "human", "humid", "hump", "humus", "hun", "hunch", "hung", "hunk", "hunt", "hurd", "hurl", "huron", "hurrah", "hurry", "hurst", "hurt", "hurty", "hush", "husky", "hut", "hutch", "hv", "hw", "hx", "hy", "hyde", "hydra", "hydro", "hyena", "hying", "hyman", "hymen", "hymn", "hymnal", "hz", "i", "i'd", "i'll", "i'm", "i's", "i've", "ia", "iambic", "ian", "ib", "ibex", "ibid", "ibis", "ibm", "ibn", "ic", "icc", "ice", "icing", "icky", "icon", "icy", "id", "ida", "idaho", "idea", "ideal", "idiom", "idiot", "idle", "idol", "idyll", "ie", "ieee", "if", "iffy", "ifni", "ig", "igloo", "igor", "ih", "ii", "iii", "iiii", "ij", "ijk", "ik", "ike", "il", "ileum", "iliac", "iliad", "ill", "illume", "ilona", "im", "image", "imbue", "imp", "impel", "import", "impute", "in", "inane", "inapt", "inc", "inca", "incest", "inch", "incur", "index", "india", "indies", "indy", "inept", "inert", "infect", "infer", "infima", "infix", "infra", "ingot", "inhere", "injun", "ink", "inlay", "inlet", "inman", "inn", "inner", "input", "insect", "inset", "insult", "intend", "inter", "into", "inure", "invoke", "io", "ion", "ionic", "iota", "iowa", "ip", "ipso", "iq", "ir", "ira", "iran", "iraq", "irate", "ire", "irene", "iris", "irish", "irk", "irma", "iron", "irony", "irs", "irvin", "irwin", "is", "isaac", "isabel", "ising", "isis", "islam", "island", "isle", "isn't", "israel", "issue", "it", "it&t", "it'd", "it'll", "italy", "itch", "item", "ito", "itt", "iu", "iv", "ivan", "ive", "ivory", "ivy", "iw", "ix", "iy", "iz", "j", "j's", "ja", "jab", "jack", "jacky", "jacm", "jacob", "jacobi", "jade", "jag", "jail", "jaime", "jake", "jam", "james", "jan", "jane", "janet", "janos", "janus", "japan", "jar", "jason", "java", "jaw", "jay", "jazz", "jazzy", "jb", "jc", "jd", "je", "jean", "jed", "jeep", "jeff", "jejune", "jelly", "jenny", "jeres", "jerk", "jerky", "jerry", "jersey", "jess", "jesse", "jest", "jesus", "jet", "jew", "jewel", "jewett", "jewish", "jf", "jg", "jh", "ji", "jibe", "jiffy", "jig", "jill", "jilt", "jim", "jimmy", "jinx", "jive", "jj", "jjj", "jjjj", "jk", "jkl", "jl", "jm", "jn", "jo", "joan", "job", "jock", "jockey", "joe", "joel", "joey", "jog", "john", "johns", "join", "joint", "joke", "jolla", "jolly", "jolt", "jon", "jonas", "jones", "jorge", "jose", "josef", "joshua", "joss", "jostle", "jot", "joule", "joust", "jove", "jowl", "jowly", "joy", "joyce", "jp", "jq", "jr", "js", "jt", "ju", "juan", "judas", "judd", "jude", "judge", "judo", "judy", "jug", "juggle", "juice", "juicy", "juju", "juke", "jukes", "julep", "jules", "julia", "julie", "julio", "july", "jumbo", "jump", "jumpy", "junco", "june", "junk", "junky", "juno", "junta", "jura", "jure", "juror", "jury", "just", "jut", "jute", "jv", "jw", "jx", "jy", "jz", "k", "k's", "ka", "kabul", "kafka", "kahn", "kajar", "kale", "kalmia", "kane", "kant", "kapok", "kappa", "karate", "karen", "karl", "karma", "karol", "karp", "kate", "kathy", "katie", "katz", "kava", "kay", "kayo", "kazoo", "kb", "kc", "kd", "ke", "keats", "keel", "keen", "keep", "keg", "keith", "keller", "kelly", "kelp", "kemp", "ken", "keno", "kent", "kenya", "kepler", "kept", "kern", "kerr", "kerry", "ketch", "kevin", "key", "keyed", "keyes", "keys", "kf", "kg", "kh", "khaki", "khan", "khmer", "ki", "kick", "kid", "kidde", "kidney", "kiev", "kigali", "kill", "kim", "kin", "kind", "king", "kink", "kinky", "kiosk", "kiowa", "kirby", "kirk", "kirov", "kiss", "kit", "kite", "kitty", "kiva", "kivu", "kiwi", "kj", "kk", "kkk", "kkkk", "kl", "klan", "klaus", "klein", "kline", "klm", "klux", "km", "kn", "knack", "knapp", "knauer", "knead", "knee", "kneel", "knelt", "knew", "knick", "knife", "knit", "knob", "knock", "knoll", "knot", "knott", "know", "known", "knox", "knurl", "ko", "koala", "koch", "kodak", "kola", "kombu", "kong", "koran", "korea", "kp", "kq", "kr", "kraft", "krause", "kraut", "krebs", "kruse", "ks", "kt", "ku", "kudo", "kudzu", "kuhn", "kulak", "kurd", "kurt", "kv", "kw", "kx", "ky", "kyle", "kyoto", "kz", "l", "l's", "la", "lab", "laban", "label", "labia", "labile", "lac", "lace", "lack", "lacy", "lad", "laden", "ladle", "lady", "lag", "lager", "lagoon", "lagos", "laid", "lain", "lair", "laity", "lake", "lam", "lamar", "lamb", "lame", "lamp", "lana", "lance", "land", "lane", "lang", "lange", "lanka", "lanky", "lao", "laos", "lap", "lapel", "lapse", "larch", "lard", "lares", "large", "lark", "larkin", "larry", "lars", "larva", "lase", "lash", "lass", "lasso", "last", "latch", "late", "later", "latest", "latex", "lath", "lathe", "latin", "latus", "laud", "laue", "laugh", "launch", "laura", "lava", "law", "lawn", "lawson", "lax", "lay", "layup", "laze", "lazy", "lb", "lc", "ld", "le", "lea", "leach", "lead", "leaf", "leafy", "leak", "leaky", "lean", "leap", "leapt", "lear", "learn", "lease", "leash", "least", "leave", "led", "ledge", "lee", "leech", "leeds", "leek", "leer", "leery", "leeway", "left", "lefty", "leg", "legal", "leggy", "legion", "leigh", "leila", "leland", "lemma", "lemon", "len", "lena", "lend", "lenin", "lenny", "lens", "lent", "leo", "leon", "leona", "leone", "leper", "leroy", "less", "lessee", "lest", "let", "lethe", "lev", "levee", "level", "lever", "levi", "levin", "levis", "levy", "lew", "lewd", "lewis", "leyden", "lf", "lg", "lh", "li", "liar", "libel", "libido", "libya", "lice", "lick", "lid", "lie", "lied", "lien", "lieu", "life", "lifo", "lift", "light", "like", "liken", "lila", "lilac", "lilly", "lilt", "lily", "lima", "limb", "limbo", "lime", "limit", "limp", "lin", "lind", "linda", "linden", "line", "linen", "lingo", "link", "lint", "linus", "lion", "lip", "lipid", "lisa", "lise", "lisle", "lisp", "list", "listen", "lit", "lithe", "litton", "live", "liven", "livid", "livre", "liz", "lizzie", "lj", "lk", "ll", "lll", "llll", "lloyd", "lm", "lmn", "ln", "lo", "load", "loaf", "loam", "loamy", "loan", "loath", "lob", "lobar", "lobby", "lobe", "lobo", "local", "loci", "lock", "locke", "locus", "lodge", "loeb", "loess", "loft", "lofty", "log", "logan", "loge", "logic", "loin", "loire", "lois", "loiter", "loki", "lola", "loll", "lolly", "lomb", "lome", "lone", "long", "look", "loom", "loon", "loop", "loose", "loot", "lop", "lope", "lopez", "lord", "lore", "loren", "los", "lose", "loss", "lossy", "lost", "lot", "lotte", "lotus", "lou", "loud", "louis", "louise", "louse", "lousy", "louver", "love", "low", "lowe", "lower", "lowry", "loy", "loyal", "lp", "lq", "lr", "ls", "lsi", "lt", "ltv", "lu", "lucas", "lucia", "lucid", "luck", "lucky", "lucre", "lucy", "lug", "luge", "luger", "luis", "luke", "lull", "lulu", "lumbar", "lumen", "lump", "lumpy", "lunar", "lunch", "lund", "lung", "lunge", "lura", "lurch", "lure", "lurid", "lurk", "lush", "lust", "lusty", "lute", "lutz", "lux", "luxe", "luzon", "lv", "lw", "lx", "ly", "lydia", "lye", "lying", "lykes", "lyle", "lyman", "lymph", "lynch", "lynn", "lynx", "lyon", "lyons", "lyra", "lyric", "lz", "m", "m&m", "m's", "ma", "mabel", "mac", "mace", "mach", "macho", "mack", "mackey", "macon", "macro", "mad", "madam", "made", "madman", "madsen", "mae", "magi", "magic", "magma", "magna", "magog", "maid", "maier", "mail", "maim", "main", "maine", "major", "make", "malady", "malay", "male", "mali", "mall", "malt", "malta", "mambo", "mamma", "mammal", "man", "mana", "manama", "mane", "mange", "mania", "manic", "mann", "manna", "manor", "mans", "manse", "mantle", "many", "mao", "maori", "map", "maple", "mar", "marc", "march", "marco", "marcy", "mardi", "mare", "margo", "maria", "marie", "marin", "marine", "mario", "mark", "marks", "marlin", "marrow", "marry", "mars", "marsh", "mart", "marty", "marx", "mary", "maser", "mash", "mask", "mason", "masque", "mass", "mast", "mat", "match", "mate", "mateo", "mater", "math", "matte", "maul", "mauve", "mavis", "maw", "mawr", "max", "maxim", "maxima", "may", "maya", "maybe", "mayer", "mayhem", "mayo", "mayor", "mayst", "mazda", "maze", "mb", "mba", "mc", "mccoy", "mcgee", "mckay", "mckee", "mcleod", "md", "me", "mead", "meal", "mealy", "mean", "meant", "meat", "meaty", "mecca", "mecum", "medal", "medea", "media", "medic", "medley", "meek", "meet", "meg", "mega", "meier", "meir", "mel", "meld", "melee", "mellow", "melon", "melt", "memo", "memoir", "men", "mend", "menlo", "menu", "merck", "mercy", "mere", "merge", "merit", "merle", "merry", "mesa", "mescal", "mesh", "meson", "mess", "messy", "met", "metal", "mete", "meter", "metro", "mew", "meyer", "meyers", "mezzo", "mf", "mg", "mh", "mi", "miami", "mica", "mice", "mickey", "micky", "micro", "mid", "midas", "midge", "midst", "mien", "miff", "mig", "might", "mike", "mila", "milan", "milch", "mild", "mildew", "mile", "miles", "milk", "milky", "mill", "mills", "milt", "mimi", "mimic", "mince", "mind", "mine", "mini", "minim", "mink", "minnow", "minor", "minos", "minot", "minsk", "mint", "minus", "mira", "mirage", "mire", "mirth", "miser", "misery", "miss", "missy", "mist", "misty", "mit", "mite", "mitre", "mitt", "mix", "mixup", "mizar", "mj", "mk", "ml", "mm", "mmm", "mmmm", "mn", "mno", "mo", "moan", "moat", "mob", "mobil", "mock", "modal", "mode", "model", "modem", "modish", "moe", "moen", "mohr", "moire", "moist", "molal", "molar", "mold", "mole", "moll", "mollie", "molly", "molt", "molten", "mommy", "mona", "monad", "mondo", "monel", "money", "monic", "monk", "mont", "monte", "month", "monty", "moo", "mood", "moody", "moon", "moor", "moore", "moose", "moot", "mop", "moral", "morale", "moran", "more", "morel", "morn", "moron", "morse", "morsel", "mort", "mosaic", "moser", "moses", "moss", "mossy", "most", "mot", "motel", "motet", "moth", "mother", "motif", "motor", "motto", "mould", "mound", "mount", "mourn", "mouse", "mousy", "mouth", "move", "movie", "mow", "moyer", "mp", "mph", "mq", "mr", "mrs", "ms", "mt", "mu", "much", "muck", "mucus", "mud", "mudd", "muddy", "muff", "muffin", "mug", "muggy", "mugho", "muir", "mulch", "mulct", "mule", "mull", "multi", "mum", "mummy", "munch", "mung", "munson", "muon", "muong", "mural", "muriel", "murk", "murky", "murre", "muse", "mush", "mushy", "music", "musk", "muslim", "must", "musty", "mute", "mutt", "muzak", "muzo", "mv", "mw", "mx", "my", "myel", "myers", "mylar", "mynah", "myopia", "myra", "myron", "myrrh", "myself", "myth", "mz", "n", "n's", "na", "naacp", "nab", "nadir", "nag", "nagoya", "nagy", "naiad", "nail", "nair", "naive", "naked", "name", "nan", "nancy", "naomi", "nap", "nary", "nasa", "nasal", "nash", "nasty", "nat", "natal", "nate", "nato", "natty", "nature", "naval", "nave", "navel", "navy", "nay", "nazi", "nb", "nbc", "nbs", "nc", "ncaa", "ncr", "nd", "ne", "neal", "near", "neat", "neath", "neck", "ned", "nee", "need", "needy", "neff", "negate", "negro", "nehru", "neil", "nell", "nelsen", "neon", "nepal", "nero", "nerve", "ness", "nest", "net", "neuron", "neva", "neve", "new", "newel", "newt", "next", "nf", "ng", "nh", "ni", "nib", "nibs", "nice", "nicety", "niche", "nick", "niece", "niger", "nigh", "night", "nih", "nikko", "nil", "nile", "nimbus", "nimh", "nina", "nine", "ninth", "niobe", "nip", "nit", "nitric", "nitty", "nixon", "nj", "nk", "nl", "nm", "nn", "nnn", "nnnn", "no", "noaa", "noah", "nob", "nobel", "noble", "nod", "nodal", "node", "noel", "noise", "noisy", "nolan", "noll", "nolo", "nomad", "non", "nonce", "none", "nook", "noon", "noose", "nop", "nor", "nora", "norm", "norma", "north", "norway", "nose", "not", "notch", "note", "notre", "noun", "nov", "nova", "novak", "novel", "novo", "now", "np", "nq", "nr", "nrc", "ns", "nsf", "nt", "ntis", "nu", "nuance", "nubia", "nuclei", "nude", "nudge", "null", "numb", "nun", "nurse", "nut", "nv", "nw", "nx", "ny", "nyc", "nylon", "nymph", "nyu", "nz", "o", "o'er", "o's", "oa", "oaf", "oak", "oaken", "oakley", "oar", "oases", "oasis", "oat", "oath", "ob", "obese", "obey", "objet", "oboe", "oc", "occur", "ocean", "oct", "octal", "octave", "octet", "od", "odd", "ode", "odin", "odium", "oe", "of", "off", "offal", "offend", "offer", "oft", "often", "og", "ogden", "ogle", "ogre", "oh", "ohio", "ohm", "ohmic", "oi", "oil", "oily", "oint", "oj", "ok", "okay", "ol", "olaf", "olav", "old", "olden", "oldy", "olga", "olin", "olive", "olsen", "olson", "om", "omaha", "oman", "omega", "omen", "omit", "on", "once", "one", "onion", "only", "onset", "onto", "onus", "onward", "onyx", "oo", "ooo", "oooo", "ooze", "op", "opal", "opec", "opel", "open", "opera", "opium", "opt", "optic", "opus", "oq", "or", "oral", "orate", "orb", "orbit", "orchid", "ordain", "order", "ore", "organ", "orgy", "orin", "orion", "ornery", "orono", "orr", "os", "osaka", "oscar", "osier", "oslo", "ot", "other", "otis", "ott", "otter", "otto", "ou", "ouch", "ought", "ounce", "our", "oust", "out", "ouvre", "ouzel", "ouzo", "ov", "ova", "oval", "ovary", "ovate", "oven", "over", "overt", "ovid", "ow", "owe", "owens", "owing", "owl", "owly", "own", "ox", "oxen", "oxeye", "oxide", "oxnard", "oy", "oz", "ozark", "ozone", "p", "p's", "pa", "pablo", "pabst", "pace", "pack", "packet", "pact", "pad", "paddy", "padre", "paean", "pagan", "page", "paid", "pail", "pain", "paine", "paint", "pair", "pal", "pale", "pall", "palm", "palo", "palsy", "pam", "pampa", "pan", "panama", "panda", "pane", "panel", "pang", "panic", "pansy", "pant", "panty", "paoli", "pap", "papa", "papal", "papaw", "paper", "pappy", "papua", "par", "parch", "pardon", "pare", "pareto", "paris", "park", "parke", "parks", "parr", "parry", "parse", "part", "party", "pascal", "pasha", "paso", "pass", "passe", "past", "paste", "pasty", "pat", "patch", "pate", "pater", "path", "patio", "patsy", "patti", "patton", "patty", "paul", "paula", "pauli", "paulo", "pause", "pave", "paw", "pawn", "pax", "pay", "payday", "payne", "paz", "pb", "pbs", "pc", "pd", "pe", "pea", "peace", "peach", "peak", "peaky", "peal", "peale", "pear", "pearl", "pease", "peat", "pebble", "pecan", "peck", "pecos", "pedal", "pedro", "pee", "peed", "peek", "peel", "peep", "peepy", "peer", "peg", "peggy", "pelt", "pen", "penal", "pence", "pencil", "pend", "penh", "penn", "penna", "penny", "pent", "peony", "pep", "peppy", "pepsi", "per", "perch", "percy", "perez", "peril", "perk", "perky", "perle", "perry", "persia", "pert", "perth", "peru", "peruse", "pest", "peste", "pet", "petal", "pete", "peter", "petit", "petri", "petty", "pew", "pewee", "pf", "pg", "ph", "ph.d", "phage", "phase", "phd", "phenol", "phi", "phil", "phlox", "phon", "phone", "phony", "photo", "phyla", "physic", "pi", "piano", "pica", "pick", "pickup", "picky", "pie", "piece", "pier", "pierce", "piety", "pig", "piggy", "pike", "pile", "pill", "pilot", "pimp", "pin", "pinch", "pine", "ping", "pinion", "pink", "pint", "pinto", "pion", "piotr", "pious", "pip", "pipe", "piper", "pique", "pit", "pitch", "pith", "pithy", "pitney", "pitt", "pity", "pius", "pivot", "pixel", "pixy", "pizza", "pj", "pk", "pl", "place", "plague", "plaid", "plain", "plan", "plane", "plank", "plant", "plasm", "plat", "plate", "plato", "play", "playa", "plaza", "plea", "plead", "pleat", "pledge", "pliny", "plod", "plop", "plot", "plow", "pluck", "plug", "plum", "plumb", "plume", "plump", "plunk", "plus", "plush", "plushy", "pluto", "ply", "pm", "pn", "po", "poach", "pobox", "pod", "podge", "podia", "poe", "poem", "poesy", "poet", "poetry", "pogo", "poi", "point", "poise", "poke", "pol", "polar", "pole", "police", "polio", "polis", "polk", "polka", "poll", "polo", "pomona", "pomp", "ponce", "pond", "pong", "pont", "pony", "pooch", "pooh", "pool", "poole", "poop", "poor", "pop", "pope", "poppy", "porch", "pore", "pork", "porous", "port", "porte", "portia", "porto", "pose", "posey", "posh", "posit", "posse", "post", "posy", "pot", "potts", "pouch", "pound", "pour", "pout", "pow", "powder", "power", "pp", "ppm", "ppp", "pppp", "pq", "pqr", "pr", "prado", "pram", "prank", "pratt", "pray", "preen", "prefix", "prep", "press", "prexy", "prey", "priam", "price", "prick", "pride", "prig", "prim", "prima", "prime", "primp", "prince", "print", "prior", "prism", "prissy", "privy", "prize", "pro", "probe", "prod", "prof", "prom", "prone", "prong", "proof", "prop", "propyl", "prose", "proud", "prove", "prow", "prowl", "proxy", "prune", "pry", "ps", "psalm", "psi", "psych", "pt", "pta", "pu", "pub", "puck", "puddly", "puerto", "puff", "puffy", "pug", "pugh", "puke", "pull", "pulp", "pulse", "puma", "pump", "pun", "punch", "punic", "punish", "punk", "punky", "punt", "puny", "pup", "pupal", "pupil", "puppy", "pure", "purge", "purl", "purr", "purse", "pus", "pusan", "pusey", "push", "pussy", "put", "putt", "putty", "pv", "pvc", "pw", "px", "py", "pygmy", "pyle", "pyre", "pyrex", "pyrite", "pz", "q", "q's", "qa", "qatar", "qb", "qc", "qd", "qe", "qed", "qf", "qg", "qh", "qi", "qj", "qk", "ql", "qm", "qn", "qo", "qp", "qq", "qqq", "qqqq", "qr", "qrs", "qs", "qt", "qu", "qua", "quack", "quad", "quaff", "quail", "quake", "qualm", "quark", "quarry", "quart", "quash", "quasi", "quay", "queasy", "queen", "queer", "quell", "query", "quest", "queue", "quick", "quid", "quiet", "quill", "quilt", "quinn", "quint", "quip", "quirk", "quirt", "quit", "quite", "quito", "quiz", "quo", "quod", "quota", "quote", "qv", "qw", "qx", "qy", "qz", "r", "r&d", "r's", "ra", "rabat", "rabbi", "rabbit", "rabid", "rabin", "race", "rack", "racy", "radar", "radii", "radio", "radium", "radix", "radon", "rae", "rafael", "raft", "rag", "rage", "raid", "rail", "rain", "rainy", "raise", "raj", "rajah", "rake", "rally", "ralph", "ram", "raman", "ramo", "ramp", "ramsey", "ran", "ranch", "rand", "randy", "rang", "range", "rangy", "rank", "rant", "raoul", "rap", "rape", "rapid", "rapt", "rare", "rasa", "rascal", "rash", "rasp", "rat", "rata", "rate", "rater", "ratio", "rattle", "raul", "rave", "ravel", "raven", "raw", "ray", "raze", "razor", "rb", "rc", "rca", "rd", "re", "reach", "read", "ready", "reagan", "real", "realm", "ream", "reap", "rear", "reave", "reb", "rebel", "rebut", "recipe", "reck", "recur", "red", "redeem", "reduce", "reed", "reedy", "reef", "reek", "reel", "reese", "reeve", "refer", "regal", "regina", "regis", "reich", "reid", "reign", "rein", "relax", "relay", "relic", "reman", "remedy", "remit", "remus", "rena", "renal", "rend", "rene", "renown", "rent", "rep", "repel", "repent", "resin", "resort", "rest", "ret", "retch", "return", "reub", "rev", "reveal", "revel", "rever", "revet", "revved", "rex", "rf", "rg", "rh", "rhea", "rheum", "rhine", "rhino", "rho", "rhoda", "rhode", "rhyme", "ri", "rib", "rica", "rice", "rich", "rick", "rico", "rid", "ride", "ridge", "rifle", "rift", "rig", "riga", "rigel", "riggs", "right", "rigid", "riley", "rill", "rilly", "rim", "rime", "rimy", "ring", "rink", "rinse", "rio", "riot", "rip", "ripe", "ripen", "ripley", "rise", "risen", "risk", "risky", "rite", "ritz", "rival", "riven", "river", "rivet", "riyadh", "rj", "rk", "rl", "rm", "rn", "ro", "roach", "road", "roam", "roar", "roast", "rob", "robe", "robin", "robot", "rock", "rocket", "rocky", "rod", "rode", "rodeo", "roe", "roger", "rogue", "roil", "role", "roll", "roman", "rome", "romeo", "romp", "ron", "rondo", "rood", "roof", "rook", "rookie", "rooky", "room", "roomy", "roost", "root", "rope", "rosa", "rose", "rosen", "ross", "rosy", "rot", "rotc", "roth", "rotor", "rouge", "rough", "round", "rouse", "rout", "route", "rove", "row", "rowdy", "rowe", "roy", "royal", "royce", "rp", "rpm", "rq", "rr", "rrr", "rrrr", "rs", "rst", "rsvp", "rt", "ru", "ruanda", "rub", "rube", "ruben", "rubin", "rubric", "ruby", "ruddy", "rude", "rudy", "rue", "rufus", "rug", "ruin", "rule", "rum", "rumen", "rummy", "rump", "rumpus", "run", "rune", "rung", "runge", "runic", "runt", "runty", "rupee", "rural", "ruse", "rush", "rusk", "russ", "russo", "rust", "rusty", "rut", "ruth", "rutty", "rv", "rw", "rx", "ry", "ryan", "ryder", "rye", "rz", "s", "s's", "sa", "sabine", "sable", "sabra", "sac", "sachs", "sack", "sad", "saddle", "sadie", "safari", "safe", "sag", "saga", "sage", "sago", "said", "sail", "saint", "sake", "sal", "salad", "sale", "salem", "saline", "salk", "salle", "sally", "salon", "salt", "salty", "salve", "salvo", "sam", "samba", "same", "sammy", "samoa", "samuel", "san", "sana", "sand", "sandal", "sandy", "sane", "sang", "sank", "sans", "santa", "santo", "sao", "sap", "sappy", "sara", "sarah", "saran", "sari", "sash", "sat", "satan", "satin", "satyr", "sauce", "saucy", "saud", "saudi", "saul", "sault", "saute", "save", "savoy", "savvy", "saw", "sawyer", "sax", "saxon", "say", "sb", "sc", "scab", "scala", "scald", "scale", "scalp", "scam", "scamp", "scan", "scant", "scar", "scare", "scarf", "scary", "scat", "scaup", "scene", "scent", "school", "scion", "scm", "scoff", "scold", "scoop", "scoot", "scope", "scops", "score", "scoria", "scorn", "scot", "scott", "scour", "scout", "scowl", "scram", "scrap", "scrape", "screw", "scrim", "scrub", "scuba", "scud", "scuff", "scull", "scum", "scurry", "sd", "se", "sea", "seal", "seam", "seamy", "sean", "sear", "sears", "season", "seat", "sec", "secant", "sect", "sedan", "seder", "sedge", "see", "seed", "seedy", "seek", "seem", "seen", "seep", "seethe", "seize", "self", "sell", "selma", "semi", "sen", "send", "seneca", "senor", "sense", "sent", "sentry", "seoul", "sepal", "sepia", "sepoy", "sept", "septa", "sequin", "sera", "serf", "serge", "serif", "serum", "serve", "servo", "set", "seth", "seton", "setup", "seven", "sever", "severe", "sew", "sewn", "sex", "sexy", "sf", "sg", "sh", "shack", "shad", "shade", "shady", "shafer", "shaft", "shag", "shah", "shake", "shaken", "shako", "shaky", "shale", "shall", "sham", "shame", "shank", "shape", "shard", "share", "shari", "shark", "sharp", "shave", "shaw", "shawl", "shay", "she", "she'd", "shea", "sheaf", "shear", "sheath", "shed", "sheen", "sheep", "sheer", "sheet", "sheik", "shelf", "shell", "shied", "shift", "shill", "shim", "shin", "shine", "shinto", "shiny", "ship", "shire", "shirk", "shirt", "shish", "shiv", "shoal", "shock", "shod", "shoe", "shoji", "shone", "shoo", "shook", "shoot", "shop", "shore", "short", "shot", "shout", "shove", "show", "shown", "showy", "shrank", "shred", "shrew", "shrike", "shrub", "shrug", "shu", "shuck", "shun", "shunt", "shut", "shy", "si", "sial", "siam", "sian", "sib", "sibley", "sibyl", "sic", "sick", "side", "sidle", "siege", "siena", "sieve", "sift", "sigh", "sight", "sigma", "sign", "signal", "signor", "silas", "silk", "silky", "sill", "silly", "silo", "silt", "silty", "sima", "simon", "simons", "sims", "sin", "sinai", "since", "sine", "sinew", "sing", "singe", "sinh", "sink", "sinus", "sioux", "sip", "sir", "sire", "siren", "sis", "sisal", "sit", "site", "situ", "situs", "siva", "six", "sixgun", "sixth", "sixty", "size", "sj", "sk", "skat", "skate", "skeet", "skew", "ski", "skid", "skied", "skiff", "skill", "skim", "skimp", "skimpy", "skin", "skip", "skirt", "skit", "skulk", "skull", "skunk", "sky", "skye", "sl", "slab", "slack", "slag", "slain", "slake", "slam", "slang", "slant", "slap", "slash", "slat", "slate", "slater", "slav", "slave", "slay", "sled", "sleek", "sleep", "sleet", "slept", "slew", "slice", "slick", "slid", "slide", "slim", "slime", "slimy", "sling", "slip", "slit", "sliver", "sloan", "slob", "sloe", "slog", "sloop", "slop", "slope", "slosh", "slot", "sloth", "slow", "slug", "sluice", "slum", "slump", "slung", "slur", "slurp", "sly", "sm", "smack", "small", "smart", "smash", "smear", "smell", "smelt", "smile", "smirk", "smith", "smithy", "smog", "smoke", "smoky", "smug", "smut", "sn", "snack", "snafu", "snag", "snail", "snake", "snap", "snare", "snark", "snarl", "snatch", "sneak", "sneer", "snell", "snick", "sniff", "snip", "snipe", "snob", "snook", "snoop", "snore", "snort", "snout", "snow", "snowy", "snub", "snuff", "snug", "so", "soak", "soap", "soapy", "soar", "sob", "sober", "social", "sock", "sod", "soda", "sofa", "sofia", "soft", "soften", "soggy", "soil", "sol", "solar", "sold", "sole", "solemn", "solid", "solo", "solon", "solve", "soma", "somal", "some", "son", "sonar", "song", "sonic", "sonny", "sonora", "sony", "soon", "soot", "sooth", "sop", "sora", "sorb", "sore", "sorry", "sort", "sos", "sou", "sough", "soul", "sound", "soup", "sour", "source", "sousa", "south", "sow", "sown", "soy", "soya", "sp", "spa", "space", "spade", "spain", "span", "spar", "spare", "sparge", "spark", "spasm", "spat", "spate", "spawn", "spay", "speak", "spear", "spec", "speck", "sped", "speed", "spell", "spend", "spent", "sperm", "sperry", "spew", "spica", "spice", "spicy", "spike", "spiky", "spill", "spilt", "spin", "spine", "spiny", "spire", "spiro", "spit", "spite", "spitz", "splat", "splay", "spline", "split", "spoil", "spoke", "spoof", "spook", "spooky", "spool", "spoon", "spore", "sport", "spot", "spout", "sprain", "spray", "spree", "sprig", "spruce", "sprue", "spud", "spume", "spun", "spunk", "spur", "spurn", "spurt", "spy", "sq", "squad", "squat", "squaw", "squibb", "squid", "squint", "sr", "sri", "ss", "sss", "ssss", "sst", "st", "st.", "stab", "stack", "stacy", "staff", "stag", "stage", "stagy", "stahl", "staid", "stain", "stair", "stake", "stale", "stalk", "stall", "stamp", "stan", "stance", "stand", "stank", "staph", "star", "stare", "stark", "starr", "start", "stash", "state", "statue", "stave", "stay", "stead", "steak", "steal", "steam", "steed", "steel", "steele", "steen", "steep", "steer", "stein", "stella", "stem", "step", "stern", "steve", "stew", "stick", "stiff", "stile", "still", "stilt", "sting", "stingy", "stink", "stint", "stir", "stock", "stoic", "stoke", "stole", "stomp", "stone", "stony", "stood", "stool", "stoop", "stop", "store", "storey", "stork", "storm", "story", "stout", "stove", "stow", "strafe", "strap", "straw", "stray", "strewn", "strip", "stroll", "strom", "strop", "strum", "strut", "stu", "stuart", "stub", "stuck", "stud", "study", "stuff", "stuffy", "stump", "stun", "stung", "stunk", "stunt", "sturm", "style", "styli", "styx", "su", "suave", "sub", "subtly", "such", "suck", "sud", "sudan", "suds", "sue", "suey", "suez", "sugar", "suit", "suite", "sulfa", "sulk", "sulky", "sully", "sultry", "sum", "sumac", "summon", "sun", "sung", "sunk", "sunny", "sunset", "suny", "sup", "super", "supra", "sure", "surf", "surge", "sus", "susan", "sushi", "susie", "sutton", "sv", "sw", "swab", "swag", "swain", "swam", "swami", "swamp", "swampy", "swan", "swank", "swap", "swarm", "swart", "swat", "swath", "sway", "swear", "sweat", "sweaty", "swede", "sweep", "sweet", "swell", "swelt", "swept", "swift", "swig", "swim", "swine", "swing", "swipe", "swirl", "swish", "swiss", "swoop", "sword", "swore", "sworn", "swum", "swung", "sx", "sy", "sybil", "sykes", "sylow", "sylvan", "synge", "synod", "syria", "syrup", "sz", "t", "t's", "ta", "tab", "table", "taboo", "tabu", "tabula", "tacit", "tack", "tacky", "tacoma", "tact", "tad", "taffy", "taft", "tag", "tahoe", "tail", "taint", "take", "taken", "talc", "tale", "talk", "talky", "tall", "tallow", "tally", "talon", "talus", "tam", "tame", "tamp", "tampa", "tan", "tang", "tango", "tangy", "tanh", "tank", "tansy", "tanya", "tao", "taos", "tap", "tapa", "tape", "taper", "tapir", "tapis", "tappa", "tar", "tara", "tardy", "tariff", "tarry", "tart", "task", "tass", "taste", "tasty", "tat", "tate", "tater", "tattle", "tatty", "tau", "taunt", "taut", "tavern", "tawny", "tax", "taxi", "tb", "tc", "td", "te", "tea", "teach", "teal", "team", "tear", "tease", "teat", "tech", "tecum", "ted", "teddy", "tee", "teem", "teen", "teensy", "teet", "teeth", "telex", "tell", "tempo", "tempt", "ten", "tend", "tenet", "tenney", "tenon", "tenor", "tense", "tensor", "tent", "tenth", "tepee", "tepid", "term", "tern", "terra", "terre", "terry", "terse", "tess", "test", "testy", "tete", "texan", "texas", "text", "tf", "tg", "th", "thai", "than", "thank", "that", "thaw", "the", "thea", "thee", "theft", "their", "them", "theme", "then", "there", "these", "theta", "they", "thick", "thief", "thigh", "thin", "thine", "thing", "think", "third", "this", "thong", "thor", "thorn", "thorny", "those", "thou", "thread", "three", "threw", "throb", "throes", "throw", "thrum", "thud", "thug", "thule", "thumb", "thump", "thus", "thy", "thyme", "ti", "tiber", "tibet", "tibia", "tic", "tick", "ticket", "tid", "tidal", "tidbit", "tide", "tidy", "tie", "tied", "tier", "tift", "tiger", "tight", "til", "tilde", "tile", "till", "tilt", "tilth", "tim", "time", "timex", "timid", "timon", "tin", "tina", "tine", "tinge", "tint", "tiny", "tioga", "tip", "tipoff", "tippy", "tipsy", "tire", "tit", "titan", "tithe", "title", "titus", "tj", "tk", "tl", "tm", "tn", "tnt", "to", "toad", "toady", "toast", "toby", "today", "todd", "toe", "tofu", "tog", "togo", "togs", "toil", "toilet", "token", "tokyo", "told", "toll", "tom", "tomb", "tome", "tommy", "ton", "tonal", "tone", "tong", "toni", "tonic", "tonk", "tonsil", "tony", "too", "took", "tool", "toot", "tooth", "top", "topaz", "topic", "topple", "topsy", "tor", "torah", "torch", "tore", "tori", "torn", "torr", "torso", "tort", "torus", "tory", "toss", "tot", "total", "tote", "totem", "touch", "tough", "tour", "tout", "tow", "towel", "tower", "town", "toxic", "toxin", "toy", "tp", "tq", "tr", "trace", "track", "tract", "tracy", "trade", "trag", "trail", "train", "trait", "tram", "tramp", "trap", "trash", "trawl", "tray", "tread", "treat", "treble", "tree", "trek", "trench", "trend", "tress", "triad", "trial", "tribe", "trick", "tried", "trig", "trill", "trim", "trio", "trip", "tripe", "trite", "triton", "trod", "troll", "troop", "trot", "trout", "troy", "truce", "truck", "trudge", "trudy", "true", "truly", "trump", "trunk", "truss", "trust", "truth", "trw", "try", "ts", "tsar", "tt", "ttl", "ttt", "tttt", "tty", "tu", "tub", "tuba", "tube", "tuck", "tudor", "tuff", "tuft", "tug", "tulane", "tulip", "tulle", "tulsa", "tum", "tun", "tuna", "tune", "tung", "tunic", "tunis", "tunnel", "tuple", "turf", "turin", "turk", "turn", "turvy", "tusk", "tussle", "tutor", "tutu", "tuv", "tv", "tva", "tw", "twa", "twain", "tweak", "tweed", "twice", "twig", "twill", "twin", "twine", "twirl", "twist", "twisty", "twit", "two", "twx", "tx", "ty", "tyburn", "tying", "tyler", "type", "typic", "typo", "tyson", "tz", "u", "u's", "ua", "ub", "uc", "ucla", "ud", "ue", "uf", "ug", "ugh", "ugly", "uh", "ui", "uj", "uk", "ul", "ulan", "ulcer", "ultra", "um", "umber", "umbra", "umpire", "un", "unary", "uncle", "under", "unify", "union", "unit", "unite", "unity", "unix", "until", "uo", "up", "upend", "uphold", "upon", "upper", "uproar", "upset", "uptake", "upton", "uq", "ur", "urban", "urbane", "urea", "urge", "uri", "urine", "uris", "urn", "ursa", "us", "usa", "usaf", "usage", "usc", "usda", "use", "useful", "usgs", "usher", "usia", "usn", "usps", "ussr", "usual", "usurp", "usury", "ut", "utah", "utica", "utile", "utmost", "utter", "uu", "uuu", "uuuu", "uv", "uvw", "uw", "ux", "uy", "uz", "v", "v's", "va", "vacua", "vacuo", "vade", "vaduz", "vague", "vail", "vain", "vale", "valet", "valeur", "valid", "value", "valve", "vamp", "van", "vance", "vane", "vary", "vase", "vast", "vat", "vault", "vb", "vc", "vd", "ve", "veal", "veda", "vee", "veer", "veery", "vega", "veil", "vein", "velar", "veldt", "vella", "vellum", "venal", "vend", "venial", "venom", "vent", "venus", "vera", "verb", "verde", "verdi", "verge", "verity", "verna", "verne", "versa", "verse", "verve", "very", "vessel", "vest", "vet", "vetch", "veto", "vex", "vf", "vg", "vh", "vi", "via", "vial", "vicar", "vice", "vichy", "vicky", "vida", "video", "vie", "viet", "view", "vigil", "vii", "viii", "vile", "villa", "vine", "vinyl", "viola", "violet", "virgil", "virgo", "virus", "vis", "visa", "vise", "visit", "visor", "vista", "vita", "vitae", "vital", "vito", "vitro", "viva", "vivian", "vivid", "vivo", "vixen", "viz", "vj", "vk", "vl", "vm", "vn", "vo", "vocal", "vogel", "vogue", "voice", "void", "volt", "volta", "volvo", "vomit", "von", "voss", "vote", "vouch", "vow", "vowel", "vp", "vq", "vr", "vs", "vt", "vu", "vulcan", "vv", "vvv", "vvvv", "vw", "vx", "vy", "vying", "vz", "w", "w's", "wa", "waals", "wac", "wack", "wacke", "wacky", "waco", "wad", "wade", "wadi", "wafer", "wag", "wage", "waggle", "wah", "wahl", "wail", "waist", "wait", "waite", "waive", "wake", "waken", "waldo", "wale", "walk", "walkie", "wall", "walls", "wally", "walsh", "walt", "walton", "waltz", "wan", "wand", "wane", "wang", "want", "war", "ward", "ware", "warm", "warmth", "warn", "warp", "warren", "wart", "warty", "wary", "was", "wash", "washy", "wasp", "wast", "waste", "watch", "water", "watt", "watts", "wave", "wavy", "wax", "waxen", "waxy", "way", "wayne", "wb", "wc", "wd", "we", "we'd", "we'll", "we're", "we've", "weak", "weal", "wealth", "wean", "wear", "weary", "weave", "web", "webb", "weber", "weco", "wed", "wedge", "wee", "weed", "weedy", "week", "weeks", "weep", "wehr", "wei", "weigh", "weir", "weird", "weiss", "welch", "weld", "well", "wells", "welsh", "welt", "wendy", "went", "wept", "were", "wert", "west", "wet", "wf", "wg", "wh", "whack", "whale", "wham", "wharf", "what", "wheat", "whee", "wheel", "whelk", "whelm", "whelp", "when", "where", "whet", "which", "whiff", "whig", "while", "whim", "whine", "whinny", "whip", "whir", "whirl", "whisk", "whit", "white", "whiz", "who", "who'd", "whoa", "whole", "whom", "whoop", "whoosh", "whop", "whose", "whup", "why", "wi", "wick", "wide", "widen", "widow", "width", "wield", "wier", "wife", "wig", "wild", "wile", "wiley", "wilkes", "will", "willa", "wills", "wilma", "wilt", "wily", "win", "wince", "winch", "wind", "windy", "wine", "wing", "wink", "winnie", "wino", "winter", "winy", "wipe", "wire", "wiry", "wise", "wish", "wishy", "wisp", "wispy", "wit", "witch", "with", "withe", "withy", "witt", "witty", "wive", "wj", "wk", "wl", "wm", "wn", "wo", "woe", "wok", "woke", "wold", "wolf", "wolfe", "wolff", "wolve", "woman", "womb", "women", "won", "won't", "wonder", "wong", "wont", "woo", "wood", "woods", "woody", "wool", "woozy", "word", "wordy", "wore", "work", "world", "worm", "wormy", "worn", "worry", "worse", "worst", "worth", "wotan", "would", "wound", "wove", "woven", "wow", "wp", "wq", "wr", "wrack", "wrap", "wrath", "wreak", "wreck", "wrest", "wring", "wrist", "writ", "write", "writhe", "wrong", "wrote", "wry", "ws", "wt", "wu", "wuhan", "wv", "ww", "www", "wwww", "wx", "wxy", "wy", "wyatt", "wyeth", "wylie", "wyman", "wyner", "wynn", "wz", "x", "x's", "xa", "xb", "xc", "xd", "xe", "xenon", "xerox", "xf", "xg", "xh", "xi", "xj", "xk", "xl", "xm", "xn", "xo", "xp", "xq", "xr", "xs", "xt", "xu", "xv", "xw", "xx", "xxx", "xxxx", "xy", "xylem", "xyz", "xz", "y", "y's", "ya", "yacht", "yah", "yak", "yale", "yalta", "yam", "yamaha", "yang", "yank", "yap", "yaqui", "yard", "yarn", "yates", "yaw", "yawl", "yawn", "yb", "yc", "yd", "ye", "yea", "yeah", "year", "yearn", "yeast", "yeasty", "yeats", "yell", "yelp", "yemen", "yen", "yet", "yf", "yg", "yh", "yi", "yield", "yin", "yip", "yj", "yk", "yl", "ym", "ymca", "yn", "yo", "yodel", "yoder", "yoga", "yogi", "yoke", "yokel", "yolk", "yon", "yond", "yore", "york", "yost", "you", "you'd", "young", "your", "youth", "yow", "yp", "yq", "yr", "ys", "yt", "yu", "yucca", "yuck", "yuh", "yuki", "yukon", "yule", "yv", "yves", "yw", "ywca", "yx", "yy", "yyy", "yyyy", "yz", "z", "z's", "za", "zag", "zaire", "zan", "zap", "zazen", "zb", "zc", "zd", "ze", "zeal", "zealot", "zebra", "zeiss", "zen", "zero", "zest", "zesty", "zeta", "zeus", "zf", "zg", "zh", "zi", "zig", "zilch", "zinc", "zing", "zion", "zip", "zj", "zk", "zl", "zloty", "zm", "zn", "zo", "zoe", "zomba", "zone", "zoo", "zoom", "zorn", "zp", "zq", "zr", "zs", "zt", "zu", "zurich", "zv", "zw", "zx", "zy", "zz", "zzz", "zzzz", "0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25", "26", "27", "28", "29", "30", "31", "32", "33", "34", "35", "36", "37", "38", "39", "40", "41", "42", "43", "44", "45", "46", "47", "48", "49", "50", "51", "52", "53", "54", "55", "56", "57", "58", "59", "60", "61", "62", "63", "64", "65", "66", "67", "68", "69", "70", "71", "72", "73", "74", "75", "76", "77", "78", "79", "80", "81", "82", "83", "84", "85", "86", "87", "88", "89", "90", "91", "92", "93", "94", "95", "96", "97", "98", "99", "100", "101", "111", "123", "200", "222", "234", "300", "333", "345", "400", "444", "456", "500", "555", "567", "600", "666", "678", "700", "777", "789", "800", "888", "900", "999", "1000", "1111", "1234", "1492", "1500", "1600", "1700", "1776", "1800", "1812", "1900", "1910", "1920", "1925", "1930", "1935", "1940", "1945", "1950", "1955", "1960", "1965", "1970", "1975", "1980", "1985", "1990", "1991", "1992", "1993", "1994", "1995", "1996", "1997", "2000", "2001", "2020", "2222", "2345", "2468", "3000", "3333", "3456", "4000", "4321", "4444", "4567", "5000", "5555", "5678", "6000", "6666", "6789", "7000", "7777", "8000", "8888", "9000", "9876", "9999", "100th", "101st", "10th", "11th", "12th", "13th", "14th", "15th", "16th", "17th", "18th", "19th", "1st", "20th", "21st", "22nd", "23rd", "24th", "25th", "26th", "27th", "28th", "29th", "2nd", "30th", "31st", "32nd", "33rd", "34th", "35th", "36th", "37th", "38th", "39th", "3rd", "40th", "41st", "42nd", "43rd", "44th", "45th", "46th", "47th", "48th", "49th", "4th", "50th", "51st", "52nd", "53rd", "54th", "55th", "56th", "57th", "58th", "59th", "5th", "60th", "61st", "62nd", "63rd", "65th", "66th", "67th", "68th", "69th", "6th", "70th", "71st", "72nd", "73rd", "74th", "75th", "76th", "77th", "78th", "79th", "7th", "80th", "81st", "82nd", "83rd", "84th", "85th", "86th", "87th", "88th", "89th", "8th", "90th", "91st", "92nd", "93rd", "94th", "95th", "96th", "97th", "98th", "99th", "9th", "!", "!!", "\"", "#", "##", "$", "$$", "%", "%%", "&", "(", "()", ")", "*", "**", "+", "-", ":", ";", "=", "?", "??", "@"},
+	"eff_large": {"abacus", "abdomen", "abdominal", "abide", "abiding", "ability", "ablaze", "able", "abnormal", "abrasion", "abrasive", "abreast", "abridge", "abroad", "abruptly", "absence", "absentee", "absently", "absinthe", "absolute", "absolve", "abstain", "abstract", "absurd", "accent", "acclaim", "acclimate", "accompany", "account", "accuracy", "accurate", "accustom", "acetone", "achiness", "aching", "acid", "acorn", "acquaint", "acquire", "acre", "acrobat", "acronym", "acting", "action", "activate", "activator", "active", "activism", "activist", "activity", "actress", "acts", "acutely", "acuteness", "aeration", "aerobics", "aerosol", "aerospace", "afar", "affair", "affected", "affecting", "affection", "affidavit", "affiliate", "affirm", "affix", "afflicted", "affluent", "afford", "affront", "aflame", "afloat", "aflutter", "afoot", "afraid", "afterglow", "afterlife", "aftermath", "aftermost", "afternoon", "aged", "ageless", "agency", "agenda", "agent", "aggregate", "aghast", "agile", "agility", "aging", "agnostic", "agonize", "agonizing", "agony", "agreeable", "agreeably", "agreed", "agreeing", "agreement", "aground", "ahead", "ahoy", "aide", "aids", "aim", "ajar", "alabaster", "alarm", "albatross", "album", "alfalfa", "algebra", "algorithm", "alias", "alibi", "alienable", "alienate", "aliens", "alike", "alive", "alkaline", "alkalize", "almanac", "almighty", "almost", "aloe", "aloft", "aloha", "alone", "alongside", "aloof", "alphabet", "alright", "although", "altitude", "alto", "aluminum", "alumni", "always", "amaretto", "amaze", "amazingly", "amber", "ambiance", "ambiguity", "ambiguous", "ambition", "ambitious", "ambulance", "ambush", "amendable", "amendment", "amends", "amenity", "amiable", "amicably", "amid", "amigo", "amino", "amiss", "ammonia", "ammonium", "amnesty", "amniotic", "among", "amount", "amperage", "ample", "amplifier", "amplify", "amply", "amuck", "amulet", "amusable", "amused", "amusement", "amuser", "amusing", "anaconda", "anaerobic", "anagram", "anatomist", "anatomy", "anchor", "anchovy", "ancient", "android", "anemia", "anemic", "aneurism", "anew", "angelfish", "angelic", "anger", "angled", "angler", "angles", "angling", "angrily", "angriness", "anguished", "angular", "animal", "animate", "animating", "animation", "animator", "anime", "animosity", "ankle", "annex", "annotate", "announcer", "annoying", "annually", "annuity", "anointer", "another", "answering", "antacid", "antarctic", "anteater", "antelope", "antennae", "anthem", "anthill", "anthology", "antibody", "antics", "antidote", "antihero", "antiquely", "antiques", "antiquity", "antirust", "antitoxic", "antitrust", "antiviral", "antivirus", "antler", "antonym", "antsy", "anvil", "anybody", "anyhow", "anymore", "anyone", "anyplace", "anything", "anytime", "anyway", "anywhere", "aorta", "apache", "apostle", "appealing", "appear", "appease", "appeasing", "appendage", "appendix", "appetite", "appetizer", "applaud", "applause", "apple", "appliance", "applicant", "applied", "apply", "appointee", "appraisal", "appraiser", "apprehend", "approach", "approval", "approve", "apricot", "april", "apron", "aptitude", "aptly", "aqua", "aqueduct", "arbitrary", "arbitrate", "ardently", "area", "arena", "arguable", "arguably", "argue", "arise", "armadillo", "armband", "armchair", "armed", "armful", "armhole", "arming", "armless", "armoire", "armored", "armory", "armrest", "army", "aroma", "arose", "around", "arousal", "arrange", "array", "arrest", "arrival", "arrive", "arrogance", "arrogant", "arson", "art", "ascend", "ascension", "ascent", "ascertain", "ashamed", "ashen", "ashes", "ashy", "aside", "askew", "asleep", "asparagus", "aspect", "aspirate", "aspire", "aspirin", "astonish", "astound", "astride", "astrology", "astronaut", "astronomy", "astute", "atlantic", "atlas", "atom", "atonable", "atop", "atrium", "atrocious", "atrophy", "attach", "attain", "attempt", "attendant", "attendee", "attention", "attentive", "attest", "attic", "attire", "attitude", "attractor", "attribute", "atypical", "auction", "audacious", "audacity", "audible", "audibly", "audience", "audio", "audition", "augmented", "august", "authentic", "author", "autism", "autistic", "autograph", "automaker", "automated", "automatic", "autopilot", "available", "avalanche", "avatar", "avenge", "avenging", "avenue", "average", "aversion", "avert", "aviation", "aviator", "avid", "avoid", "await", "awaken", "award", "aware", "awhile", "awkward", "awning", "awoke", "awry", "axis", "babble", "babbling", "babied", "baboon", "backache", "backboard", "backboned", "backdrop", "backed", "backer", "backfield", "backfire", "backhand", "backing", "backlands", "backlash", "backless", "backlight", "backlit", "backlog", "backpack", "backpedal", "backrest", "backroom", "backshift", "backside", "backslid", "backspace", "backspin", "backstab", "backstage", "backtalk", "backtrack", "backup", "backward", "backwash", "backwater", "backyard", "bacon", "bacteria", "bacterium", "badass", "badge", "badland", "badly", "badness", "baffle", "baffling", "bagel", "bagful", "baggage", "bagged", "baggie", "bagginess", "bagging", "baggy", "bagpipe", "baguette", "baked", "bakery", "bakeshop", "baking", "balance", "balancing", "balcony", "balmy", "balsamic", "bamboo", "banana", "banish", "banister", "banjo", "bankable", "bankbook", "banked", "banker", "banking", "banknote", "bankroll", "banner", "bannister", "banshee", "banter", "barbecue", "barbed", "barbell", "barber", "barcode", "barge", "bargraph", "barista", "baritone", "barley", "barmaid", "barman", "barn", "barometer", "barrack", "barracuda", "barrel", "barrette", "barricade", "barrier", "barstool", "bartender", "barterer", "bash", "basically", "basics", "basil", "basin", "basis", "basket", "batboy", "batch", "bath", "baton", "bats", "battalion", "battered", "battering", "battery", "batting", "battle", "bauble", "bazooka", "blabber", "bladder", "blade", "blah", "blame", "blaming", "blanching", "blandness", "blank", "blaspheme", "blasphemy", "blast", "blatancy", "blatantly", "blazer", "blazing", "bleach", "bleak", "bleep", "blemish", "blend", "bless", "blighted", "blimp", "bling", "blinked", "blinker", "blinking", "blinks", "blip", "blissful", "blitz", "blizzard", "bloated", "bloating", "blob", "blog", "bloomers", "blooming", "blooper", "blot", "blouse", "blubber", "bluff", "bluish", "blunderer", "blunt", "blurb", "blurred", "blurry", "blurt", "blush", "blustery", "boaster", "boastful", "boasting", "boat", "bobbed", "bobbing", "bobble", "bobcat", "bobsled", "bobtail", "bodacious", "body", "bogged", "boggle", "bogus", "boil", "bok", "bolster", "bolt", "bonanza", "bonded", "bonding", "bondless", "boned", "bonehead", "boneless", "bonelike", "boney", "bonfire", "bonnet", "bonsai", "bonus", "bony", "boogeyman", "boogieman", "book", "boondocks", "booted", "booth", "bootie", "booting", "bootlace", "bootleg", "boots", "boozy", "borax", "boring", "borough", "borrower", "borrowing", "boss", "botanical", "botanist", "botany", "botch", "both", "bottle", "bottling", "bottom", "bounce", "bouncing", "bouncy", "bounding", "boundless", "bountiful", "bovine", "boxcar", "boxer", "boxing", "boxlike", "boxy", "breach", "breath", "breeches", "breeching", "breeder", "breeding", "breeze", "breezy", "brethren", "brewery", "brewing", "briar", "bribe", "brick", "bride", "bridged", "brigade", "bright", "brilliant", "brim", "bring", "brink", "brisket", "briskly", "briskness", "bristle", "brittle", "broadband", "broadcast", "broaden", "broadly", "broadness", "broadside", "broadways", "broiler", "broiling", "broken", "broker", "bronchial", "bronco", "bronze", "bronzing", "brook", "broom", "brought", "browbeat", "brownnose", "browse", "browsing", "bruising", "brunch", "brunette", "brunt", "brush", "brussels", "brute", "brutishly", "bubble", "bubbling", "bubbly", "buccaneer", "bucked", "bucket", "buckle", "buckshot", "buckskin", "bucktooth", "buckwheat", "buddhism", "buddhist", "budding", "buddy", "budget", "buffalo", "buffed", "buffer", "buffing", "buffoon", "buggy", "bulb", "bulge", "bulginess", "bulgur", "bulk", "bulldog", "bulldozer", "bullfight", "bullfrog", "bullhorn", "bullion", "bullish", "bullpen", "bullring", "bullseye", "bullwhip", "bully", "bunch", "bundle", "bungee", "bunion", "bunkbed", "bunkhouse", "bunkmate", "bunny", "bunt", "busboy", "bush", "busily", "busload", "bust", "busybody", "buzz", "cabana", "cabbage", "cabbie", "cabdriver", "cable", "caboose", "cache", "cackle", "cacti", "cactus", "caddie", "caddy", "cadet", "cadillac", "cadmium", "cage", "cahoots", "cake", "calamari", "calamity", "calcium", "calculate", "calculus", "caliber", "calibrate", "calm", "caloric", "calorie", "calzone", "camcorder", "cameo", "camera", "camisole", "camper", "campfire", "camping", "campsite", "campus", "canal", "canary", "cancel", "candied", "candle", "candy", "cane", "canine", "canister", "cannabis", "canned", "canning", "cannon", "cannot", "canola", "canon", "canopener", "canopy", "canteen", "canyon", "capable", "capably", "capacity", "cape", "capillary", "capital", "capitol", "capped", "capricorn", "capsize", "capsule", "caption", "captivate", "captive", "captivity", "capture", "caramel", "carat", "caravan", "carbon", "cardboard", "carded", "cardiac", "cardigan", "cardinal", "cardstock", "carefully", "caregiver", "careless", "caress", "caretaker", "cargo", "caring", "carless", "carload", "carmaker", "carnage", "carnation", "carnival", "carnivore", "carol", "carpenter", "carpentry", "carpool", "carport", "carried", "carrot", "carrousel", "carry", "cartel", "cartload", "carton", "cartoon", "cartridge", "cartwheel", "carve", "carving", "carwash", "cascade", "case", "cash", "casing", "casino", "casket", "cassette", "casually", "casualty", "catacomb", "catalog", "catalyst", "catalyze", "catapult", "cataract", "catatonic", "catcall", "catchable", "catcher", "catching", "catchy", "caterer", "catering", "catfight", "catfish", "cathedral", "cathouse", "catlike", "catnap", "catnip", "catsup", "cattail", "cattishly", "cattle", "catty", "catwalk", "caucasian", "caucus", "causal", "causation", "cause", "causing", "cauterize", "caution", "cautious", "cavalier", "cavalry", "caviar", "cavity", "cedar", "celery", "celestial", "celibacy", "celibate", "celtic", "cement", "census", "ceramics", "ceremony", "certainly", "certainty", "certified", "certify", "cesarean", "cesspool", "chafe", "chaffing", "chain", "chair", "chalice", "challenge", "chamber", "chamomile", "champion", "chance", "change", "channel", "chant", "chaos", "chaperone", "chaplain", "chapped", "chaps", "chapter", "character", "charbroil", "charcoal", "charger", "charging", "chariot", "charity", "charm", "charred", "charter", "charting", "chase", "chasing", "chaste", "chastise", "chastity", "chatroom", "chatter", "chatting", "chatty", "cheating", "cheddar", "cheek", "cheer", "cheese", "cheesy", "chef", "chemicals", "chemist", "chemo", "cherisher", "cherub", "chess", "chest", "chevron", "chevy", "chewable", "chewer", "chewing", "chewy", "chief", "chihuahua", "childcare", "childhood", "childish", "childless", "childlike", "chili", "chill", "chimp", "chip", "chirping", "chirpy", "chitchat", "chivalry", "chive", "chloride", "chlorine", "choice", "chokehold", "choking", "chomp", "chooser", "choosing", "choosy", "chop", "chosen", "chowder", "chowtime", "chrome", "chubby", "chuck", "chug", "chummy", "chump", "chunk", "churn", "chute", "cider", "cilantro", "cinch", "cinema", "cinnamon", "circle", "circling", "circular", "circulate", "circus", "citable", "citadel", "citation", "citizen", "citric", "citrus", "city", "civic", "civil", "clad", "claim", "clambake", "clammy", "clamor", "clamp", "clamshell", "clang", "clanking", "clapped", "clapper", "clapping", "clarify", "clarinet", "clarity", "clash", "clasp", "class", "clatter", "clause", "clavicle", "claw", "clay", "clean", "clear", "cleat", "cleaver", "cleft", "clench", "clergyman", "clerical", "clerk", "clever", "clicker", "client", "climate", "climatic", "cling", "clinic", "clinking", "clip", "clique", "cloak", "clobber", "clock", "clone", "cloning", "closable", "closure", "clothes", "clothing", "cloud", "clover", "clubbed", "clubbing", "clubhouse", "clump", "clumsily", "clumsy", "clunky", "clustered", "clutch", "clutter", "coach", "coagulant", "coastal", "coaster", "coasting", "coastland", "coastline", "coat", "coauthor", "cobalt", "cobbler", "cobweb", "cocoa", "coconut", "cod", "coeditor", "coerce", "coexist", "coffee", "cofounder", "cognition", "cognitive", "cogwheel", "coherence", "coherent", "cohesive", "coil", "coke", "cola", "cold", "coleslaw", "coliseum", "collage", "collapse", "collar", "collected", "collector", "collide", "collie", "collision", "colonial", "colonist", "colonize", "colony", "colossal", "colt", "coma", "come", "comfort", "comfy", "comic", "coming", "comma", "commence", "commend", "comment", "commerce", "commode", "commodity", "commodore", "common", "commotion", "commute", "commuting", "compacted", "compacter", "compactly", "compactor", "companion", "company", "compare", "compel", "compile", "comply", "component", "composed", "composer", "composite", "compost", "composure", "compound", "compress", "comprised", "computer", "computing", "comrade", "concave", "conceal", "conceded", "concept", "concerned", "concert", "conch", "concierge", "concise", "conclude", "concrete", "concur", "condense", "condiment", "condition", "condone", "conducive", "conductor", "conduit", "cone", "confess", "confetti", "confidant", "confident", "confider", "confiding", "configure", "confined", "confining", "confirm", "conflict", "conform", "confound", "confront", "confused", "confusing", "confusion", "congenial", "congested", "congrats", "congress", "conical", "conjoined", "conjure", "conjuror", "connected", "connector", "consensus", "consent", "console", "consoling", "consonant", "constable", "constant", "constrain", "constrict", "construct", "consult", "consumer", "consuming", "contact", "container", "contempt", "contend", "contented", "contently", "contents", "contest", "context", "contort", "contour", "contrite", "control", "contusion", "convene", "convent", "copartner", "cope", "copied", "copier", "copilot", "coping", "copious", "copper", "copy", "coral", "cork", "cornball", "cornbread", "corncob", "cornea", "corned", "corner", "cornfield", "cornflake", "cornhusk", "cornmeal", "cornstalk", "corny", "coronary", "coroner", "corporal", "corporate", "corral", "correct", "corridor", "corrode", "corroding", "corrosive", "corsage", "corset", "cortex", "cosigner", "cosmetics", "cosmic", "cosmos", "cosponsor", "cost", "cottage", "cotton", "couch", "cough", "could", "countable", "countdown", "counting", "countless", "country", "county", "courier", "covenant", "cover", "coveted", "coveting", "coyness", "cozily", "coziness", "cozy", "crabbing", "crabgrass", "crablike", "crabmeat", "cradle", "cradling", "crafter", "craftily", "craftsman", "craftwork", "crafty", "cramp", "cranberry", "crane", "cranial", "cranium", "crank", "crate", "crave", "craving", "crawfish", "crawlers", "crawling", "crayfish", "crayon", "crazed", "crazily", "craziness", "crazy", "creamed", "creamer", "creamlike", "crease", "creasing", "creatable", "create", "creation", "creative", "creature", "credible", "credibly", "credit", "creed", "creme", "creole", "crepe", "crept", "crescent", "crested", "cresting", "crestless", "crevice", "crewless", "crewman", "crewmate", "crib", "cricket", "cried", "crier", "crimp", "crimson", "cringe", "cringing", "crinkle", "crinkly", "crisped", "crisping", "crisply", "crispness", "crispy", "criteria", "critter", "croak", "crock", "crook", "croon", "crop", "cross", "crouch", "crouton", "crowbar", "crowd", "crown", "crucial", "crudely", "crudeness", "cruelly", "cruelness", "cruelty", "crumb", "crummiest", "crummy", "crumpet", "crumpled", "cruncher", "crunching", "crunchy", "crusader", "crushable", "crushed", "crusher", "crushing", "crust", "crux", "crying", "cryptic", "crystal", "cubbyhole", "cube", "cubical", "cubicle", "cucumber", "cuddle", "cuddly", "cufflink", "culinary", "culminate", "culpable", "culprit", "cultivate", "cultural", "culture", "cupbearer", "cupcake", "cupid", "cupped", "cupping", "curable", "curator", "curdle", "cure", "curfew", "curing", "curled", "curler", "curliness", "curling", "curly", "curry", "curse", "cursive", "cursor", "curtain", "curtly", "curtsy", "curvature", "curve", "curvy", "cushy", "cusp", "cussed", "custard", "custodian", "custody", "customary", "customer", "customize", "customs", "cut", "cycle", "cyclic", "cycling", "cyclist", "cylinder", "cymbal", "cytoplasm", "cytoplast", "dab", "dad", "daffodil", "dagger", "daily", "daintily", "dainty", "dairy", "daisy", "dallying", "dance", "dancing", "dandelion", "dander", "dandruff", "dandy", "danger", "dangle", "dangling", "daredevil", "dares", "daringly", "darkened", "darkening", "darkish", "darkness", "darkroom", "darling", "darn", "dart", "darwinism", "dash", "dastardly", "data", "datebook", "dating", "daughter", "daunting", "dawdler", "dawn", "daybed", "daybreak", "daycare", "daydream", "daylight", "daylong", "dayroom", "daytime", "dazzler", "dazzling", "deacon", "deafening", "deafness", "dealer", "dealing", "dealmaker", "dealt", "dean", "debatable", "debate", "debating", "debit", "debrief", "debtless", "debtor", "debug", "debunk", "decade", "decaf", "decal", "decathlon", "decay", "deceased", "deceit", "deceiver", "deceiving", "december", "decency", "decent", "deception", "deceptive", "decibel", "decidable", "decimal", "decimeter", "decipher", "deck", "declared", "decline", "decode", "decompose", "decorated", "decorator", "decoy", "decrease", "decree", "dedicate", "dedicator", "deduce", "deduct", "deed", "deem", "deepen", "deeply", "deepness", "deface", "defacing", "defame", "default", "defeat", "defection", "defective", "defendant", "defender", "defense", "defensive", "deferral", "deferred", "defiance", "defiant", "defile", "defiling", "define", "definite", "deflate", "deflation", "deflator", "deflected", "deflector", "defog", "deforest", "defraud", "defrost", "deftly", "defuse", "defy", "degraded", "degrading", "degrease", "degree", "dehydrate", "deity", "dejected", "delay", "delegate", "delegator", "delete", "deletion", "delicacy", "delicate", "delicious", "delighted", "delirious", "delirium", "deliverer", "delivery", "delouse", "delta", "deluge", "delusion", "deluxe", "demanding", "demeaning", "demeanor", "demise", "democracy", "democrat", "demote", "demotion", "demystify", "denatured", "deniable", "denial", "denim", "denote", "dense", "density", "dental", "dentist", "denture", "deny", "deodorant", "deodorize", "departed", "departure", "depict", "deplete", "depletion", "deplored", "deploy", "deport", "depose", "depraved", "depravity", "deprecate", "depress", "deprive", "depth", "deputize", "deputy", "derail", "deranged", "derby", "derived", "desecrate", "deserve", "deserving", "designate", "designed", "designer", "designing", "deskbound", "desktop", "deskwork", "desolate", "despair", "despise", "despite", "destiny", "destitute", "destruct", "detached", "detail", "detection", "detective", "detector", "detention", "detergent", "detest", "detonate", "detonator", "detoxify", "detract", "deuce", "devalue", "deviancy", "deviant", "deviate", "deviation", "deviator", "device", "devious", "devotedly", "devotee", "devotion", "devourer", "devouring", "devoutly", "dexterity", "dexterous", "diabetes", "diabetic", "diabolic", "diagnoses", "diagnosis", "diagram", "dial", "diameter", "diaper", "diaphragm", "diary", "dice", "dicing", "dictate", "dictation", "dictator", "difficult", "diffused", "diffuser", "diffusion", "diffusive", "dig", "dilation", "diligence", "diligent", "dill", "dilute", "dime", "diminish", "dimly", "dimmed", "dimmer", "dimness", "dimple", "diner", "dingbat", "dinghy", "dinginess", "dingo", "dingy", "dining", "dinner", "diocese", "dioxide", "diploma", "dipped", "dipper", "dipping", "directed", "direction", "directive", "directly", "directory", "direness", "dirtiness", "disabled", "disagree", "disallow", "disarm", "disarray", "disaster", "disband", "disbelief", "disburse", "discard", "discern", "discharge", "disclose", "discolor", "discount", "discourse", "discover", "discuss", "disdain", "disengage", "disfigure", "disgrace", "dish", "disinfect", "disjoin", "disk", "dislike", "disliking", "dislocate", "dislodge", "disloyal", "dismantle", "dismay", "dismiss", "dismount", "disobey", "disorder", "disown", "disparate", "disparity", "dispatch", "dispense", "dispersal", "dispersed", "disperser", "displace", "display", "displease", "disposal", "dispose", "disprove", "dispute", "disregard", "disrupt", "dissuade", "distance", "distant", "distaste", "distill", "distinct", "distort", "distract", "distress", "district", "distrust", "ditch", "ditto", "ditzy", "dividable", "divided", "dividend", "dividers", "dividing", "divinely", "diving", "divinity", "divisible", "divisibly", "division", "divisive", "divorcee", "dizziness", "dizzy", "doable", "docile", "dock", "doctrine", "document", "dodge", "dodgy", "doily", "doing", "dole", "dollar", "dollhouse", "dollop", "dolly", "dolphin", "domain", "domelike", "domestic", "dominion", "dominoes", "donated", "donation", "donator", "donor", "donut", "doodle", "doorbell", "doorframe", "doorknob", "doorman", "doormat", "doornail", "doorpost", "doorstep", "doorstop", "doorway", "doozy", "dork", "dormitory", "dorsal", "dosage", "dose", "dotted", "doubling", "douche", "dove", "down", "dowry", "doze", "drab", "dragging", "dragonfly", "dragonish", "dragster", "drainable", "drainage", "drained", "drainer", "drainpipe", "dramatic", "dramatize", "drank", "drapery", "drastic", "draw", "dreaded", "dreadful", "dreadlock", "dreamboat", "dreamily", "dreamland", "dreamless", "dreamlike", "dreamt", "dreamy", "drearily", "dreary", "drench", "dress", "drew", "dribble", "dried", "drier", "drift", "driller", "drilling", "drinkable", "drinking", "dripping", "drippy", "drivable", "driven", "driver", "driveway", "driving", "drizzle", "drizzly", "drone", "drool", "droop", "drop-down", "dropbox", "dropkick", "droplet", "dropout", "dropper", "drove", "drown", "drowsily", "drudge", "drum", "dry", "dubbed", "dubiously", "duchess", "duckbill", "ducking", "duckling", "ducktail", "ducky", "duct", "dude", "duffel", "dugout", "duh", "duke", "duller", "dullness", "duly", "dumping", "dumpling", "dumpster", "duo", "dupe", "duplex", "duplicate", "duplicity", "durable", "durably", "duration", "duress", "during", "dusk", "dust", "dutiful", "duty", "duvet", "dwarf", "dweeb", "dwelled", "dweller", "dwelling", "dwindle", "dwindling", "dynamic", "dynamite", "dynasty", "dyslexia", "dyslexic", "each", "eagle", "earache", "eardrum", "earflap", "earful", "earlobe", "early", "earmark", "earmuff", "earphone", "earpiece", "earplugs", "earring", "earshot", "earthen", "earthlike", "earthling", "earthly", "earthworm", "earthy", "earwig", "easeful", "easel", "easiest", "easily", "easiness", "easing", "eastbound", "eastcoast", "easter", "eastward", "eatable", "eaten", "eatery", "eating", "eats", "ebay", "ebony", "ebook", "ecard", "eccentric", "echo", "eclair", "eclipse", "ecologist", "ecology", "economic", "economist", "economy", "ecosphere", "ecosystem", "edge", "edginess", "edging", "edgy", "edition", "editor", "educated", "education", "educator", "eel", "effective", "effects", "efficient", "effort", "eggbeater", "egging", "eggnog", "eggplant", "eggshell", "egomaniac", "egotism", "egotistic", "either", "eject", "elaborate", "elastic", "elated", "elbow", "eldercare", "elderly", "eldest", "electable", "election", "elective", "elephant", "elevate", "elevating", "elevation", "elevator", "eleven", "elf", "eligible", "eligibly", "eliminate", "elite", "elitism", "elixir", "elk", "ellipse", "elliptic", "elm", "elongated", "elope", "eloquence", "eloquent", "elsewhere", "elude", "elusive", "elves", "email", "embargo", "embark", "embassy", "embattled", "embellish", "ember", "embezzle", "emblaze", "emblem", "embody", "embolism", "emboss", "embroider", "emcee", "emerald", "emergency", "emission", "emit", "emote", "emoticon", "emotion", "empathic", "empathy", "emperor", "emphases", "emphasis", "emphasize", "emphatic", "empirical", "employed", "employee", "employer", "emporium", "empower", "emptier", "emptiness", "empty", "emu", "enable", "enactment", "enamel", "enchanted", "enchilada", "encircle", "enclose", "enclosure", "encode", "encore", "encounter", "encourage", "encroach", "encrust", "encrypt", "endanger", "endeared", "endearing", "ended", "ending", "endless", "endnote", "endocrine", "endorphin", "endorse", "endowment", "endpoint", "endurable", "endurance", "enduring", "energetic", "energize", "energy", "enforced", "enforcer", "engaged", "engaging", "engine", "engorge", "engraved", "engraver", "engraving", "engross", "engulf", "enhance", "enigmatic", "enjoyable", "enjoyably", "enjoyer", "enjoying", "enjoyment", "enlarged", "enlarging", "enlighten", "enlisted", "enquirer", "enrage", "enrich", "enroll", "enslave", "ensnare", "ensure", "entail", "entangled", "entering", "entertain", "enticing", "entire", "entitle", "entity", "entomb", "entourage", "entrap", "entree", "entrench", "entrust", "entryway", "entwine", "enunciate", "envelope", "enviable", "enviably", "envious", "envision", "envoy", "envy", "enzyme", "epic", "epidemic", "epidermal", "epidermis", "epidural", "epilepsy", "epileptic", "epilogue", "epiphany", "episode", "equal", "equate", "equation", "equator", "equinox", "equipment", "equity", "equivocal", "eradicate", "erasable", "erased", "eraser", "erasure", "ergonomic", "errand", "errant", "erratic", "error", "erupt", "escalate", "escalator", "escapable", "escapade", "escapist", "escargot", "eskimo", "esophagus", "espionage", "espresso", "esquire", "essay", "essence", "essential", "establish", "estate", "esteemed", "estimate", "estimator", "estranged", "estrogen", "etching", "eternal", "eternity", "ethanol", "ether", "ethically", "ethics", "euphemism", "evacuate", "evacuee", "evade", "evaluate", "evaluator", "evaporate", "evasion", "evasive", "even", "everglade", "evergreen", "everybody", "everyday", "everyone", "evict", "evidence", "evident", "evil", "evoke", "evolution", "evolve", "exact", "exalted", "example", "excavate", "excavator", "exceeding", "exception", "excess", "exchange", "excitable", "exciting", "exclaim", "exclude", "excluding", "exclusion", "exclusive", "excretion", "excretory", "excursion", "excusable", "excusably", "excuse", "exemplary", "exemplify", "exemption", "exerciser", "exert", "exes", "exfoliate", "exhale", "exhaust", "exhume", "exile", "existing", "exit", "exodus", "exonerate", "exorcism", "exorcist", "expand", "expanse", "expansion", "expansive", "expectant", "expedited", "expediter", "expel", "expend", "expenses", "expensive", "expert", "expire", "expiring", "explain", "expletive", "explicit", "explode", "exploit", "explore", "exploring", "exponent", "exporter", "exposable", "expose", "exposure", "express", "expulsion", "exquisite", "extended", "extending", "extent", "extenuate", "exterior", "external", "extinct", "extortion", "extradite", "extras", "extrovert", "extrude", "extruding", "exuberant", "fable", "fabric", "fabulous", "facebook", "facecloth", "facedown", "faceless", "facelift", "faceplate", "faceted", "facial", "facility", "facing", "facsimile", "faction", "factoid", "factor", "factsheet", "factual", "faculty", "fade", "fading", "failing", "falcon", "fall", "false", "falsify", "fame", "familiar", "family", "famine", "famished", "fanatic", "fancied", "fanciness", "fancy", "fanfare", "fang", "fanning", "fantasize", "fantastic", "fantasy", "fascism", "fastball", "faster", "fasting", "fastness", "faucet", "favorable", "favorably", "favored", "favoring", "favorite", "fax", "feast", "federal", "fedora", "feeble", "feed", "feel", "feisty", "feline", "felt-tip", "feminine", "feminism", "feminist", "feminize", "femur", "fence", "fencing", "fender", "ferment", "fernlike", "ferocious", "ferocity", "ferret", "ferris", "ferry", "fervor", "fester", "festival", "festive", "festivity", "fetal", "fetch", "fever", "fiber", "fiction", "fiddle", "fiddling", "fidelity", "fidgeting", "fidgety", "fifteen", "fifth", "fiftieth", "fifty", "figment", "figure", "figurine", "filing", "filled", "filler", "filling", "film", "filter", "filth", "filtrate", "finale", "finalist", "finalize", "finally", "finance", "financial", "finch", "fineness", "finer", "finicky", "finished", "finisher", "finishing", "finite", "finless", "finlike", "fiscally", "fit", "five", "flaccid", "flagman", "flagpole", "flagship", "flagstick", "flagstone", "flail", "flakily", "flaky", "flame", "flammable", "flanked", "flanking", "flannels", "flap", "flaring", "flashback", "flashbulb", "flashcard", "flashily", "flashing", "flashy", "flask", "flatbed", "flatfoot", "flatly", "flatness", "flatten", "flattered", "flatterer", "flattery", "flattop", "flatware", "flatworm", "flavored", "flavorful", "flavoring", "flaxseed", "fled", "fleshed", "fleshy", "flick", "flier", "flight", "flinch", "fling", "flint", "flip", "flirt", "float", "flock", "flogging", "flop", "floral", "florist", "floss", "flounder", "flyable", "flyaway", "flyer", "flying", "flyover", "flypaper", "foam", "foe", "fog", "foil", "folic", "folk", "follicle", "follow", "fondling", "fondly", "fondness", "fondue", "font", "food", "fool", "footage", "football", "footbath", "footboard", "footer", "footgear", "foothill", "foothold", "footing", "footless", "footman", "footnote", "footpad", "footpath", "footprint", "footrest", "footsie", "footsore", "footwear", "footwork", "fossil", "foster", "founder", "founding", "fountain", "fox", "foyer", "fraction", "fracture", "fragile", "fragility", "fragment", "fragrance", "fragrant", "frail", "frame", "framing", "frantic", "fraternal", "frayed", "fraying", "frays", "freckled", "freckles", "freebase", "freebee", "freebie", "freedom", "freefall", "freehand", "freeing", "freeload", "freely", "freemason", "freeness", "freestyle", "freeware", "freeway", "freewill", "freezable", "freezing", "freight", "french", "frenzied", "frenzy", "frequency", "frequent", "fresh", "fretful", "fretted", "friction", "friday", "fridge", "fried", "friend", "frighten", "frightful", "frigidity", "frigidly", "frill", "fringe", "frisbee", "frisk", "fritter", "frivolous", "frolic", "from", "front", "frostbite", "frosted", "frostily", "frosting", "frostlike", "frosty", "froth", "frown", "frozen", "fructose", "frugality", "frugally", "fruit", "frustrate", "frying", "gab", "gaffe", "gag", "gainfully", "gaining", "gains", "gala", "gallantly", "galleria", "gallery", "galley", "gallon", "gallows", "gallstone", "galore", "galvanize", "gambling", "game", "gaming", "gamma", "gander", "gangly", "gangrene", "gangway", "gap", "garage", "garbage", "garden", "gargle", "garland", "garlic", "garment", "garnet", "garnish", "garter", "gas", "gatherer", "gathering", "gating", "gauging", "gauntlet", "gauze", "gave", "gawk", "gazing", "gear", "gecko", "geek", "geiger", "gem", "gender", "generic", "generous", "genetics", "genre", "gentile", "gentleman", "gently", "gents", "geography", "geologic", "geologist", "geology", "geometric", "geometry", "geranium", "gerbil", "geriatric", "germicide", "germinate", "germless", "germproof", "gestate", "gestation", "gesture", "getaway", "getting", "getup", "giant", "gibberish", "giblet", "giddily", "giddiness", "giddy", "gift", "gigabyte", "gigahertz", "gigantic", "giggle", "giggling", "giggly", "gigolo", "gilled", "gills", "gimmick", "girdle", "giveaway", "given", "giver", "giving", "gizmo", "gizzard", "glacial", "glacier", "glade", "gladiator", "gladly", "glamorous", "glamour", "glance", "glancing", "glandular", "glare", "glaring", "glass", "glaucoma", "glazing", "gleaming", "gleeful", "glider", "gliding", "glimmer", "glimpse", "glisten", "glitch", "glitter", "glitzy", "gloater", "gloating", "gloomily", "gloomy", "glorified", "glorifier", "glorify", "glorious", "glory", "gloss", "glove", "glowing", "glowworm", "glucose", "glue", "gluten", "glutinous", "glutton", "gnarly", "gnat", "goal", "goatskin", "goes", "goggles", "going", "goldfish", "goldmine", "goldsmith", "golf", "goliath", "gonad", "gondola", "gone", "gong", "good", "gooey", "goofball", "goofiness", "goofy", "google", "goon", "gopher", "gore", "gorged", "gorgeous", "gory", "gosling", "gossip", "gothic", "gotten", "gout", "gown", "grab", "graceful", "graceless", "gracious", "gradation", "graded", "grader", "gradient", "grading", "gradually", "graduate", "graffiti", "grafted", "grafting", "grain", "granddad", "grandkid", "grandly", "grandma", "grandpa", "grandson", "granite", "granny", "granola", "grant", "granular", "grape", "graph", "grapple", "grappling", "grasp", "grass", "gratified", "gratify", "grating", "gratitude", "gratuity", "gravel", "graveness", "graves", "graveyard", "gravitate", "gravity", "gravy", "gray", "grazing", "greasily", "greedily", "greedless", "greedy", "green", "greeter", "greeting", "grew", "greyhound", "grid", "grief", "grievance", "grieving", "grievous", "grill", "grimace", "grimacing", "grime", "griminess", "grimy", "grinch", "grinning", "grip", "gristle", "grit", "groggily", "groggy", "groin", "groom", "groove", "grooving", "groovy", "grope", "ground", "grouped", "grout", "grove", "grower", "growing", "growl", "grub", "grudge", "grudging", "grueling", "gruffly", "grumble", "grumbling", "grumbly", "grumpily", "grunge", "grunt", "guacamole", "guidable", "guidance", "guide", "guiding", "guileless", "guise", "gulf", "gullible", "gully", "gulp", "gumball", "gumdrop", "gumminess", "gumming", "gummy", "gurgle", "gurgling", "guru", "gush", "gusto", "gusty", "gutless", "guts", "gutter", "guy", "guzzler", "gyration", "habitable", "habitant", "habitat", "habitual", "hacked", "hacker", "hacking", "hacksaw", "had", "haggler", "haiku", "half", "halogen", "halt", "halved", "halves", "hamburger", "hamlet", "hammock", "hamper", "hamster", "hamstring", "handbag", "handball", "handbook", "handbrake", "handcart", "handclap", "handclasp", "handcraft", "handcuff", "handed", "handful", "handgrip", "handgun", "handheld", "handiness", "handiwork", "handlebar", "handled", "handler", "handling", "handmade", "handoff", "handpick", "handprint", "handrail", "handsaw", "handset", "handsfree", "handshake", "handstand", "handwash", "handwork", "handwoven", "handwrite", "handyman", "hangnail", "hangout", "hangover", "hangup", "hankering", "hankie", "hanky", "haphazard", "happening", "happier", "happiest", "happily", "happiness", "happy", "harbor", "hardcopy", "hardcore", "hardcover", "harddisk", "hardened", "hardener", "hardening", "hardhat", "hardhead", "hardiness", "hardly", "hardness", "hardship", "hardware", "hardwired", "hardwood", "hardy", "harmful", "harmless", "harmonica", "harmonics", "harmonize", "harmony", "harness", "harpist", "harsh", "harvest", "hash", "hassle", "haste", "hastily", "hastiness", "hasty", "hatbox", "hatchback", "hatchery", "hatchet", "hatching", "hatchling", "hate", "hatless", "hatred", "haunt", "haven", "hazard", "hazelnut", "hazily", "haziness", "hazing", "hazy", "headache", "headband", "headboard", "headcount", "headdress", "headed", "header", "headfirst", "headgear", "heading", "headlamp", "headless", "headlock", "headphone", "headpiece", "headrest", "headroom", "headscarf", "headset", "headsman", "headstand", "headstone", "headway", "headwear", "heap", "heat", "heave", "heavily", "heaviness", "heaving", "hedge", "hedging", "heftiness", "hefty", "helium", "helmet", "helper", "helpful", "helping", "helpless", "helpline", "hemlock", "hemstitch", "hence", "henchman", "henna", "herald", "herbal", "herbicide", "herbs", "heritage", "hermit", "heroics", "heroism", "herring", "herself", "hertz", "hesitancy", "hesitant", "hesitate", "hexagon", "hexagram", "hubcap", "huddle", "huddling", "huff", "hug", "hula", "hulk", "hull", "human", "humble", "humbling", "humbly", "humid", "humiliate", "humility", "humming", "hummus", "humongous", "humorist", "humorless", "humorous", "humpback", "humped", "humvee", "hunchback", "hundredth", "hunger", "hungrily", "hungry", "hunk", "hunter", "hunting", "huntress", "huntsman", "hurdle", "hurled", "hurler", "hurling", "hurray", "hurricane", "hurried", "hurry", "hurt", "husband", "hush", "husked", "huskiness", "hut", "hybrid", "hydrant", "hydrated", "hydration", "hydrogen", "hydroxide", "hyperlink", "hypertext", "hyphen", "hypnoses", "hypnosis", "hypnotic", "hypnotism", "hypnotist", "hypnotize", "hypocrisy", "hypocrite", "ibuprofen", "ice", "iciness", "icing", "icky", "icon", "icy", "idealism", "idealist", "idealize", "ideally", "idealness", "identical", "identify", "identity", "ideology", "idiocy", "idiom", "idly", "igloo", "ignition", "ignore", "iguana", "illicitly", "illusion", "illusive", "image", "imaginary", "imagines", "imaging", "imbecile", "imitate", "imitation", "immature", "immerse", "immersion", "imminent", "immobile", "immodest", "immorally", "immortal", "immovable", "immovably", "immunity", "immunize", "impaired", "impale", "impart", "impatient", "impeach", "impeding", "impending", "imperfect", "imperial", "impish", "implant", "implement", "implicate", "implicit", "implode", "implosion", "implosive", "imply", "impolite", "important", "importer", "impose", "imposing", "impotence", "impotency", "impotent", "impound", "imprecise", "imprint", "imprison", "impromptu", "improper", "improve", "improving", "improvise", "imprudent", "impulse", "impulsive", "impure", "impurity", "iodine", "iodize", "ion", "ipad", "iphone", "ipod", "irate", "irk", "iron", "irregular", "irrigate", "irritable", "irritably", "irritant", "irritate", "islamic", "islamist", "isolated", "isolating", "isolation", "isotope", "issue", "issuing", "italicize", "italics", "item", "itinerary", "itunes", "ivory", "ivy", "jab", "jackal", "jacket", "jackknife", "jackpot", "jailbird", "jailbreak", "jailer", "jailhouse", "jalapeno", "jam", "janitor", "january", "jargon", "jarring", "jasmine", "jaundice", "jaunt", "java", "jawed", "jawless", "jawline", "jaws", "jaybird", "jaywalker", "jazz", "jeep", "jeeringly", "jellied", "jelly", "jersey", "jester", "jet", "jiffy", "jigsaw", "jimmy", "jingle", "jingling", "jinx", "jitters", "jittery", "job", "jockey", "jockstrap", "jogger", "jogging", "john", "joining", "jokester", "jokingly", "jolliness", "jolly", "jolt", "jot", "jovial", "joyfully", "joylessly", "joyous", "joyride", "joystick", "jubilance", "jubilant", "judge", "judgingly", "judicial", "judiciary", "judo", "juggle", "juggling", "jugular", "juice", "juiciness", "juicy", "jujitsu", "jukebox", "july", "jumble", "jumbo", "jump", "junction", "juncture", "june", "junior", "juniper", "junkie", "junkman", "junkyard", "jurist", "juror", "jury", "justice", "justifier", "justify", "justly", "justness", "juvenile", "kabob", "kangaroo", "karaoke", "karate", "karma", "kebab", "keenly", "keenness", "keep", "keg", "kelp", "kennel", "kept", "kerchief", "kerosene", "kettle", "kick", "kiln", "kilobyte", "kilogram", "kilometer", "kilowatt", "kilt", "kimono", "kindle", "kindling", "kindly", "kindness", "kindred", "kinetic", "kinfolk", "king", "kinship", "kinsman", "kinswoman", "kissable", "kisser", "kissing", "kitchen", "kite", "kitten", "kitty", "kiwi", "kleenex", "knapsack", "knee", "knelt", "knickers", "knoll", "koala", "kooky", "kosher", "krypton", "kudos", "kung", "labored", "laborer", "laboring", "laborious", "labrador", "ladder", "ladies", "ladle", "ladybug", "ladylike", "lagged", "lagging", "lagoon", "lair", "lake", "lance", "landed", "landfall", "landfill", "landing", "landlady", "landless", "landline", "landlord", "landmark", "landmass", "landmine", "landowner", "landscape", "landside", "landslide", "language", "lankiness", "lanky", "lantern", "lapdog", "lapel", "lapped", "lapping", "laptop", "lard", "large", "lark", "lash", "lasso", "last", "latch", "late", "lather", "latitude", "latrine", "latter", "latticed", "launch", "launder", "laundry", "laurel", "lavender", "lavish", "laxative", "lazily", "laziness", "lazy", "lecturer", "left", "legacy", "legal", "legend", "legged", "leggings", "legible", "legibly", "legislate", "lego", "legroom", "legume", "legwarmer", "legwork", "lemon", "lend", "length", "lens", "lent", "leotard", "lesser", "letdown", "lethargic", "lethargy", "letter", "lettuce", "level", "leverage", "levers", "levitate", "levitator", "liability", "liable", "liberty", "librarian", "library", "licking", "licorice", "lid", "life", "lifter", "lifting", "liftoff", "ligament", "likely", "likeness", "likewise", "liking", "lilac", "lilly", "lily", "limb", "limeade", "limelight", "limes", "limit", "limping", "limpness", "line", "lingo", "linguini", "linguist", "lining", "linked", "linoleum", "linseed", "lint", "lion", "lip", "liquefy", "liqueur", "liquid", "lisp", "list", "litigate", "litigator", "litmus", "litter", "little", "livable", "lived", "lively", "liver", "livestock", "lividly", "living", "lizard", "lubricant", "lubricate", "lucid", "luckily", "luckiness", "luckless", "lucrative", "ludicrous", "lugged", "lukewarm", "lullaby", "lumber", "luminance", "luminous", "lumpiness", "lumping", "lumpish", "lunacy", "lunar", "lunchbox", "luncheon", "lunchroom", "lunchtime", "lung", "lurch", "lure", "luridness", "lurk", "lushly", "lushness", "luster", "lustfully", "lustily", "lustiness", "lustrous", "lusty", "luxurious", "luxury", "lying", "lyrically", "lyricism", "lyricist", "lyrics", "macarena", "macaroni", "macaw", "mace", "machine", "machinist", "magazine", "magenta", "maggot", "magical", "magician", "magma", "magnesium", "magnetic", "magnetism", "magnetize", "magnifier", "magnify", "magnitude", "magnolia", "mahogany", "maimed", "majestic", "majesty", "majorette", "majority", "makeover", "maker", "makeshift", "making", "malformed", "malt", "mama", "mammal", "mammary", "mammogram", "manager", "managing", "manatee", "mandarin", "mandate", "mandatory", "mandolin", "manger", "mangle", "mango", "mangy", "manhandle", "manhole", "manhood", "manhunt", "manicotti", "manicure", "manifesto", "manila", "mankind", "manlike", "manliness", "manly", "manmade", "manned", "mannish", "manor", "manpower", "mantis", "mantra", "manual", "many", "map", "marathon", "marauding", "marbled", "marbles", "marbling", "march", "mardi", "margarine", "margarita", "margin", "marigold", "marina", "marine", "marital", "maritime", "marlin", "marmalade", "maroon", "married", "marrow", "marry", "marshland", "marshy", "marsupial", "marvelous", "marxism", "mascot", "masculine", "mashed", "mashing", "massager", "masses", "massive", "mastiff", "matador", "matchbook", "matchbox", "matcher", "matching", "matchless", "material", "maternal", "maternity", "math", "mating", "matriarch", "matrimony", "matrix", "matron", "matted", "matter", "maturely", "maturing", "maturity", "mauve", "maverick", "maximize", "maximum", "maybe", "mayday", "mayflower", "moaner", "moaning", "mobile", "mobility", "mobilize", "mobster", "mocha", "mocker", "mockup", "modified", "modify", "modular", "modulator", "module", "moisten", "moistness", "moisture", "molar", "molasses", "mold", "molecular", "molecule", "molehill", "mollusk", "mom", "monastery", "monday", "monetary", "monetize", "moneybags", "moneyless", "moneywise", "mongoose", "mongrel", "monitor", "monkhood", "monogamy", "monogram", "monologue", "monopoly", "monorail", "monotone", "monotype", "monoxide", "monsieur", "monsoon", "monstrous", "monthly", "monument", "moocher", "moodiness", "moody", "mooing", "moonbeam", "mooned", "moonlight", "moonlike", "moonlit", "moonrise", "moonscape", "moonshine", "moonstone", "moonwalk", "mop", "morale", "morality", "morally", "morbidity", "morbidly", "morphine", "morphing", "morse", "mortality", "mortally", "mortician", "mortified", "mortify", "mortuary", "mosaic", "mossy", "most", "mothball", "mothproof", "motion", "motivate", "motivator", "motive", "motocross", "motor", "motto", "mountable", "mountain", "mounted", "mounting", "mourner", "mournful", "mouse", "mousiness", "moustache", "mousy", "mouth", "movable", "move", "movie", "moving", "mower", "mowing", "much", "muck", "mud", "mug", "mulberry", "mulch", "mule", "mulled", "mullets", "multiple", "multiply", "multitask", "multitude", "mumble", "mumbling", "mumbo", "mummified", "mummify", "mummy", "mumps", "munchkin", "mundane", "municipal", "muppet", "mural", "murkiness", "murky", "murmuring", "muscular", "museum", "mushily", "mushiness", "mushroom", "mushy", "music", "musket", "muskiness", "musky", "mustang", "mustard", "muster", "mustiness", "musty", "mutable", "mutate", "mutation", "mute", "mutilated", "mutilator", "mutiny", "mutt", "mutual", "muzzle", "myself", "myspace", "mystified", "mystify", "myth", "nacho", "nag", "nail", "name", "naming", "nanny", "nanometer", "nape", "napkin", "napped", "napping", "nappy", "narrow", "nastily", "nastiness", "national", "native", "nativity", "natural", "nature", "naturist", "nautical", "navigate", "navigator", "navy", "nearby", "nearest", "nearly", "nearness", "neatly", "neatness", "nebula", "nebulizer", "nectar", "negate", "negation", "negative", "neglector", "negligee", "negligent", "negotiate", "nemeses", "nemesis", "neon", "nephew", "nerd", "nervous", "nervy", "nest", "net", "neurology", "neuron", "neurosis", "neurotic", "neuter", "neutron", "never", "next", "nibble", "nickname", "nicotine", "niece", "nifty", "nimble", "nimbly", "nineteen", "ninetieth", "ninja", "nintendo", "ninth", "nuclear", "nuclei", "nucleus", "nugget", "nullify", "number", "numbing", "numbly", "numbness", "numeral", "numerate", "numerator", "numeric", "numerous", "nuptials", "nursery", "nursing", "nurture", "nutcase", "nutlike", "nutmeg", "nutrient", "nutshell", "nuttiness", "nutty", "nuzzle", "nylon", "oaf", "oak", "oasis", "oat", "obedience", "obedient", "obituary", "object", "obligate", "obliged", "oblivion", "oblivious", "oblong", "obnoxious", "oboe", "obscure", "obscurity", "observant", "observer", "observing", "obsessed", "obsession", "obsessive", "obsolete", "obstacle", "obstinate", "obstruct", "obtain", "obtrusive", "obtuse", "obvious", "occultist", "occupancy", "occupant", "occupier", "occupy", "ocean", "ocelot", "octagon", "octane", "october", "octopus", "ogle", "oil", "oink", "ointment", "okay", "old", "olive", "olympics", "omega", "omen", "ominous", "omission", "omit", "omnivore", "onboard", "oncoming", "ongoing", "onion", "online", "onlooker", "only", "onscreen", "onset", "onshore", "onslaught", "onstage", "onto", "onward", "onyx", "oops", "ooze", "oozy", "opacity", "opal", "open", "operable", "operate", "operating", "operation", "operative", "operator", "opium", "opossum", "opponent", "oppose", "opposing", "opposite", "oppressed", "oppressor", "opt", "opulently", "osmosis", "other", "otter", "ouch", "ought", "ounce", "outage", "outback", "outbid", "outboard", "outbound", "outbreak", "outburst", "outcast", "outclass", "outcome", "outdated", "outdoors", "outer", "outfield", "outfit", "outflank", "outgoing", "outgrow", "outhouse", "outing", "outlast", "outlet", "outline", "outlook", "outlying", "outmatch", "outmost", "outnumber", "outplayed", "outpost", "outpour", "output", "outrage", "outrank", "outreach", "outright", "outscore", "outsell", "outshine", "outshoot", "outsider", "outskirts", "outsmart", "outsource", "outspoken", "outtakes", "outthink", "outward", "outweigh", "outwit", "oval", "ovary", "oven", "overact", "overall", "overarch", "overbid", "overbill", "overbite", "overblown", "overboard", "overbook", "overbuilt", "overcast", "overcoat", "overcome", "overcook", "overcrowd", "overdraft", "overdrawn", "overdress", "overdrive", "overdue", "overeager", "overeater", "overexert", "overfed", "overfeed", "overfill", "overflow", "overfull", "overgrown", "overhand", "overhang", "overhaul", "overhead", "overhear", "overheat", "overhung", "overjoyed", "overkill", "overlabor", "overlaid", "overlap", "overlay", "overload", "overlook", "overlord", "overlying", "overnight", "overpass", "overpay", "overplant", "overplay", "overpower", "overprice", "overrate", "overreach", "overreact", "override", "overripe", "overrule", "overrun", "overshoot", "overshot", "oversight", "oversized", "oversleep", "oversold", "overspend", "overstate", "overstay", "overstep", "overstock", "overstuff", "oversweet", "overtake", "overthrow", "overtime", "overtly", "overtone", "overture", "overturn", "overuse", "overvalue", "overview", "overwrite", "owl", "oxford", "oxidant", "oxidation", "oxidize", "oxidizing", "oxygen", "oxymoron", "oyster", "ozone", "paced", "pacemaker", "pacific", "pacifier", "pacifism", "pacifist", "pacify", "padded", "padding", "paddle", "paddling", "padlock", "pagan", "pager", "paging", "pajamas", "palace", "palatable", "palm", "palpable", "palpitate", "paltry", "pampered", "pamperer", "pampers", "pamphlet", "panama", "pancake", "pancreas", "panda", "pandemic", "pang", "panhandle", "panic", "panning", "panorama", "panoramic", "panther", "pantomime", "pantry", "pants", "pantyhose", "paparazzi", "papaya", "paper", "paprika", "papyrus", "parabola", "parachute", "parade", "paradox", "paragraph", "parakeet", "paralegal", "paralyses", "paralysis", "paralyze", "paramedic", "parameter", "paramount", "parasail", "parasite", "parasitic", "parcel", "parched", "parchment", "pardon", "parish", "parka", "parking", "parkway", "parlor", "parmesan", "parole", "parrot", "parsley", "parsnip", "partake", "parted", "parting", "partition", "partly", "partner", "partridge", "party", "passable", "passably", "passage", "passcode", "passenger", "passerby", "passing", "passion", "passive", "passivism", "passover", "passport", "password", "pasta", "pasted", "pastel", "pastime", "pastor", "pastrami", "pasture", "pasty", "patchwork", "patchy", "paternal", "paternity", "path", "patience", "patient", "patio", "patriarch", "patriot", "patrol", "patronage", "patronize", "pauper", "pavement", "paver", "pavestone", "pavilion", "paving", "pawing", "payable", "payback", "paycheck", "payday", "payee", "payer", "paying", "payment", "payphone", "payroll", "pebble", "pebbly", "pecan", "pectin", "peculiar", "peddling", "pediatric", "pedicure", "pedigree", "pedometer", "pegboard", "pelican", "pellet", "pelt", "pelvis", "penalize", "penalty", "pencil", "pendant", "pending", "penholder", "penknife", "pennant", "penniless", "penny", "penpal", "pension", "pentagon", "pentagram", "pep", "perceive", "percent", "perch", "percolate", "perennial", "perfected", "perfectly", "perfume", "periscope", "perish", "perjurer", "perjury", "perkiness", "perky", "perm", "peroxide", "perpetual", "perplexed", "persecute", "persevere", "persuaded", "persuader", "pesky", "peso", "pessimism", "pessimist", "pester", "pesticide", "petal", "petite", "petition", "petri", "petroleum", "petted", "petticoat", "pettiness", "petty", "petunia", "phantom", "phobia", "phoenix", "phonebook", "phoney", "phonics", "phoniness", "phony", "phosphate", "photo", "phrase", "phrasing", "placard", "placate", "placidly", "plank", "planner", "plant", "plasma", "plaster", "plastic", "plated", "platform", "plating", "platinum", "platonic", "platter", "platypus", "plausible", "plausibly", "playable", "playback", "player", "playful", "playgroup", "playhouse", "playing", "playlist", "playmaker", "playmate", "playoff", "playpen", "playroom", "playset", "plaything", "playtime", "plaza", "pleading", "pleat", "pledge", "plentiful", "plenty", "plethora", "plexiglas", "pliable", "plod", "plop", "plot", "plow", "ploy", "pluck", "plug", "plunder", "plunging", "plural", "plus", "plutonium", "plywood", "poach", "pod", "poem", "poet", "pogo", "pointed", "pointer", "pointing", "pointless", "pointy", "poise", "poison", "poker", "poking", "polar", "police", "policy", "polio", "polish", "politely", "polka", "polo", "polyester", "polygon", "polygraph", "polymer", "poncho", "pond", "pony", "popcorn", "pope", "poplar", "popper", "poppy", "popsicle", "populace", "popular", "populate", "porcupine", "pork", "porous", "porridge", "portable", "portal", "portfolio", "porthole", "portion", "portly", "portside", "poser", "posh", "posing", "possible", "possibly", "possum", "postage", "postal", "postbox", "postcard", "posted", "poster", "posting", "postnasal", "posture", "postwar", "pouch", "pounce", "pouncing", "pound", "pouring", "pout", "powdered", "powdering", "powdery", "power", "powwow", "pox", "praising", "prance", "prancing", "pranker", "prankish", "prankster", "prayer", "praying", "preacher", "preaching", "preachy", "preamble", "precinct", "precise", "precision", "precook", "precut", "predator", "predefine", "predict", "preface", "prefix", "preflight", "preformed", "pregame", "pregnancy", "pregnant", "preheated", "prelaunch", "prelaw", "prelude", "premiere", "premises", "premium", "prenatal", "preoccupy", "preorder", "prepaid", "prepay", "preplan", "preppy", "preschool", "prescribe", "preseason", "preset", "preshow", "president", "presoak", "press", "presume", "presuming", "preteen", "pretended", "pretender", "pretense", "pretext", "pretty", "pretzel", "prevail", "prevalent", "prevent", "preview", "previous", "prewar", "prewashed", "prideful", "pried", "primal", "primarily", "primary", "primate", "primer", "primp", "princess", "print", "prior", "prism", "prison", "prissy", "pristine", "privacy", "private", "privatize", "prize", "proactive", "probable", "probably", "probation", "probe", "probing", "probiotic", "problem", "procedure", "process", "proclaim", "procreate", "procurer", "prodigal", "prodigy", "produce", "product", "profane", "profanity", "professed", "professor", "profile", "profound", "profusely", "progeny", "prognosis", "program", "progress", "projector", "prologue", "prolonged", "promenade", "prominent", "promoter", "promotion", "prompter", "promptly", "prone", "prong", "pronounce", "pronto", "proofing", "proofread", "proofs", "propeller", "properly", "property", "proponent", "proposal", "propose", "props", "prorate", "protector", "protegee", "proton", "prototype", "protozoan", "protract", "protrude", "proud", "provable", "proved", "proven", "provided", "provider", "providing", "province", "proving", "provoke", "provoking", "provolone", "prowess", "prowler", "prowling", "proximity", "proxy", "prozac", "prude", "prudishly", "prune", "pruning", "pry", "psychic", "public", "publisher", "pucker", "pueblo", "pug", "pull", "pulmonary", "pulp", "pulsate", "pulse", "pulverize", "puma", "pumice", "pummel", "punch", "punctual", "punctuate", "punctured", "pungent", "punisher", "punk", "pupil", "puppet", "puppy", "purchase", "pureblood", "purebred", "purely", "pureness", "purgatory", "purge", "purging", "purifier", "purify", "purist", "puritan", "purity", "purple", "purplish", "purposely", "purr", "purse", "pursuable", "pursuant", "pursuit", "purveyor", "pushcart", "pushchair", "pusher", "pushiness", "pushing", "pushover", "pushpin", "pushup", "pushy", "putdown", "putt", "puzzle", "puzzling", "pyramid", "pyromania", "python", "quack", "quadrant", "quail", "quaintly", "quake", "quaking", "qualified", "qualifier", "qualify", "quality", "qualm", "quantum", "quarrel", "quarry", "quartered", "quarterly", "quarters", "quartet", "quench", "query", "quicken", "quickly", "quickness", "quicksand", "quickstep", "quiet", "quill", "quilt", "quintet", "quintuple", "quirk", "quit", "quiver", "quizzical", "quotable", "quotation", "quote", "rabid", "race", "racing", "racism", "rack", "racoon", "radar", "radial", "radiance", "radiantly", "radiated", "radiation", "radiator", "radio", "radish", "raffle", "raft", "rage", "ragged", "raging", "ragweed", "raider", "railcar", "railing", "railroad", "railway", "raisin", "rake", "raking", "rally", "ramble", "rambling", "ramp", "ramrod", "ranch", "rancidity", "random", "ranged", "ranger", "ranging", "ranked", "ranking", "ransack", "ranting", "rants", "rare", "rarity", "rascal", "rash", "rasping", "ravage", "raven", "ravine", "raving", "ravioli", "ravishing", "reabsorb", "reach", "reacquire", "reaction", "reactive", "reactor", "reaffirm", "ream", "reanalyze", "reappear", "reapply", "reappoint", "reapprove", "rearrange", "rearview", "reason", "reassign", "reassure", "reattach", "reawake", "rebalance", "rebate", "rebel", "rebirth", "reboot", "reborn", "rebound", "rebuff", "rebuild", "rebuilt", "reburial", "rebuttal", "recall", "recant", "recapture", "recast", "recede", "recent", "recess", "recharger", "recipient", "recital", "recite", "reckless", "reclaim", "recliner", "reclining", "recluse", "reclusive", "recognize", "recoil", "recollect", "recolor", "reconcile", "reconfirm", "reconvene", "recopy", "record", "recount", "recoup", "recovery", "recreate", "rectal", "rectangle", "rectified", "rectify", "recycled", "recycler", "recycling", "reemerge", "reenact", "reenter", "reentry", "reexamine", "referable", "referee", "reference", "refill", "refinance", "refined", "refinery", "refining", "refinish", "reflected", "reflector", "reflex", "reflux", "refocus", "refold", "reforest", "reformat", "reformed", "reformer", "reformist", "refract", "refrain", "refreeze", "refresh", "refried", "refueling", "refund", "refurbish", "refurnish", "refusal", "refuse", "refusing", "refutable", "refute", "regain", "regalia", "regally", "reggae", "regime", "region", "register", "registrar", "registry", "regress", "regretful", "regroup", "regular", "regulate", "regulator", "rehab", "reheat", "rehire", "rehydrate", "reimburse", "reissue", "reiterate", "rejoice", "rejoicing", "rejoin", "rekindle", "relapse", "relapsing", "relatable", "related", "relation", "relative", "relax", "relay", "relearn", "release", "relenting", "reliable", "reliably", "reliance", "reliant", "relic", "relieve", "relieving", "relight", "relish", "relive", "reload", "relocate", "relock", "reluctant", "rely", "remake", "remark", "remarry", "rematch", "remedial", "remedy", "remember", "reminder", "remindful", "remission", "remix", "remnant", "remodeler", "remold", "remorse", "remote", "removable", "removal", "removed", "remover", "removing", "rename", "renderer", "rendering", "rendition", "renegade", "renewable", "renewably", "renewal", "renewed", "renounce", "renovate", "renovator", "rentable", "rental", "rented", "renter", "reoccupy", "reoccur", "reopen", "reorder", "repackage", "repacking", "repaint", "repair", "repave", "repaying", "repayment", "repeal", "repeated", "repeater", "repent", "rephrase", "replace", "replay", "replica", "reply", "reporter", "repose", "repossess", "repost", "repressed", "reprimand", "reprint", "reprise", "reproach", "reprocess", "reproduce", "reprogram", "reps", "reptile", "reptilian", "repugnant", "repulsion", "repulsive", "repurpose", "reputable", "reputably", "request", "require", "requisite", "reroute", "rerun", "resale", "resample", "rescuer", "reseal", "research", "reselect", "reseller", "resemble", "resend", "resent", "reset", "reshape", "reshoot", "reshuffle", "residence", "residency", "resident", "residual", "residue", "resigned", "resilient", "resistant", "resisting", "resize", "resolute", "resolved", "resonant", "resonate", "resort", "resource", "respect", "resubmit", "result", "resume", "resupply", "resurface", "resurrect", "retail", "retainer", "retaining", "retake", "retaliate", "retention", "rethink", "retinal", "retired", "retiree", "retiring", "retold", "retool", "retorted", "retouch", "retrace", "retract", "retrain", "retread", "retreat", "retrial", "retrieval", "retriever", "retry", "return", "retying", "retype", "reunion", "reunite", "reusable", "reuse", "reveal", "reveler", "revenge", "revenue", "reverb", "revered", "reverence", "reverend", "reversal", "reverse", "reversing", "reversion", "revert", "revisable", "revise", "revision", "revisit", "revivable", "revival", "reviver", "reviving", "revocable", "revoke", "revolt", "revolver", "revolving", "reward", "rewash", "rewind", "rewire", "reword", "rework", "rewrap", "rewrite", "rhyme", "ribbon", "ribcage", "rice", "riches", "richly", "richness", "rickety", "ricotta", "riddance", "ridden", "ride", "riding", "rifling", "rift", "rigging", "rigid", "rigor", "rimless", "rimmed", "rind", "rink", "rinse", "rinsing", "riot", "ripcord", "ripeness", "ripening", "ripping", "ripple", "rippling", "riptide", "rise", "rising", "risk", "risotto", "ritalin", "ritzy", "rival", "riverbank", "riverbed", "riverboat", "riverside", "riveter", "riveting", "roamer", "roaming", "roast", "robbing", "robe", "robin", "robotics", "robust", "rockband", "rocker", "rocket", "rockfish", "rockiness", "rocking", "rocklike", "rockslide", "rockstar", "rocky", "rogue", "roman", "romp", "rope", "roping", "roster", "rosy", "rotten", "rotting", "rotunda", "roulette", "rounding", "roundish", "roundness", "roundup", "roundworm", "routine", "routing", "rover", "roving", "royal", "rubbed", "rubber", "rubbing", "rubble", "rubdown", "ruby", "ruckus", "rudder", "rug", "ruined", "rule", "rumble", "rumbling", "rummage", "rumor", "runaround", "rundown", "runner", "running", "runny", "runt", "runway", "rupture", "rural", "ruse", "rush", "rust", "rut", "sabbath", "sabotage", "sacrament", "sacred", "sacrifice", "sadden", "saddlebag", "saddled", "saddling", "sadly", "sadness", "safari", "safeguard", "safehouse", "safely", "safeness", "saffron", "saga", "sage", "sagging", "saggy", "said", "saint", "sake", "salad", "salami", "salaried", "salary", "saline", "salon", "saloon", "salsa", "salt", "salutary", "salute", "salvage", "salvaging", "salvation", "same", "sample", "sampling", "sanction", "sanctity", "sanctuary", "sandal", "sandbag", "sandbank", "sandbar", "sandblast", "sandbox", "sanded", "sandfish", "sanding", "sandlot", "sandpaper", "sandpit", "sandstone", "sandstorm", "sandworm", "sandy", "sanitary", "sanitizer", "sank", "santa", "sapling", "sappiness", "sappy", "sarcasm", "sarcastic", "sardine", "sash", "sasquatch", "sassy", "satchel", "satiable", "satin", "satirical", "satisfied", "satisfy", "saturate", "saturday", "sauciness", "saucy", "sauna", "savage", "savanna", "saved", "savings", "savior", "savor", "saxophone", "say", "scabbed", "scabby", "scalded", "scalding", "scale", "scaling", "scallion", "scallop", "scalping", "scam", "scandal", "scanner", "scanning", "scant", "scapegoat", "scarce", "scarcity", "scarecrow", "scared", "scarf", "scarily", "scariness", "scarring", "scary", "scavenger", "scenic", "schedule", "schematic", "scheme", "scheming", "schilling", "schnapps", "scholar", "science", "scientist", "scion", "scoff", "scolding", "scone", "scoop", "scooter", "scope", "scorch", "scorebook", "scorecard", "scored", "scoreless", "scorer", "scoring", "scorn", "scorpion", "scotch", "scoundrel", "scoured", "scouring", "scouting", "scouts", "scowling", "scrabble", "scraggly", "scrambled", "scrambler", "scrap", "scratch", "scrawny", "screen", "scribble", "scribe", "scribing", "scrimmage", "script", "scroll", "scrooge", "scrounger", "scrubbed", "scrubber", "scruffy", "scrunch", "scrutiny", "scuba", "scuff", "sculptor", "sculpture", "scurvy", "scuttle", "secluded", "secluding", "seclusion", "second", "secrecy", "secret", "sectional", "sector", "secular", "securely", "security", "sedan", "sedate", "sedation", "sedative", "sediment", "seduce", "seducing", "segment", "seismic", "seizing", "seldom", "selected", "selection", "selective", "selector", "self", "seltzer", "semantic", "semester", "semicolon", "semifinal", "seminar", "semisoft", "semisweet", "senate", "senator", "send", "senior", "senorita", "sensation", "sensitive", "sensitize", "sensually", "sensuous", "sepia", "september", "septic", "septum", "sequel", "sequence", "sequester", "series", "sermon", "serotonin", "serpent", "serrated", "serve", "service", "serving", "sesame", "sessions", "setback", "setting", "settle", "settling", "setup", "sevenfold", "seventeen", "seventh", "seventy", "severity", "shabby", "shack", "shaded", "shadily", "shadiness", "shading", "shadow", "shady", "shaft", "shakable", "shakily", "shakiness", "shaking", "shaky", "shale", "shallot", "shallow", "shame", "shampoo", "shamrock", "shank", "shanty", "shape", "shaping", "share", "sharpener", "sharper", "sharpie", "sharply", "sharpness", "shawl", "sheath", "shed", "sheep", "sheet", "shelf", "shell", "shelter", "shelve", "shelving", "sherry", "shield", "shifter", "shifting", "shiftless", "shifty", "shimmer", "shimmy", "shindig", "shine", "shingle", "shininess", "shining", "shiny", "ship", "shirt", "shivering", "shock", "shone", "shoplift", "shopper", "shopping", "shoptalk", "shore", "shortage", "shortcake", "shortcut", "shorten", "shorter", "shorthand", "shortlist", "shortly", "shortness", "shorts", "shortwave", "shorty", "shout", "shove", "showbiz", "showcase", "showdown", "shower", "showgirl", "showing", "showman", "shown", "showoff", "showpiece", "showplace", "showroom", "showy", "shrank", "shrapnel", "shredder", "shredding", "shrewdly", "shriek", "shrill", "shrimp", "shrine", "shrink", "shrivel", "shrouded", "shrubbery", "shrubs", "shrug", "shrunk", "shucking", "shudder", "shuffle", "shuffling", "shun", "shush", "shut", "shy", "siamese", "siberian", "sibling", "siding", "sierra", "siesta", "sift", "sighing", "silenced", "silencer", "silent", "silica", "silicon", "silk", "silliness", "silly", "silo", "silt", "silver", "similarly", "simile", "simmering", "simple", "simplify", "simply", "sincere", "sincerity", "singer", "singing", "single", "singular", "sinister", "sinless", "sinner", "sinuous", "sip", "siren", "sister", "sitcom", "sitter", "sitting", "situated", "situation", "sixfold", "sixteen", "sixth", "sixties", "sixtieth", "sixtyfold", "sizable", "sizably", "size", "sizing", "sizzle", "sizzling", "skater", "skating", "skedaddle", "skeletal", "skeleton", "skeptic", "sketch", "skewed", "skewer", "skid", "skied", "skier", "skies", "skiing", "skilled", "skillet", "skillful", "skimmed", "skimmer", "skimming", "skimpily", "skincare", "skinhead", "skinless", "skinning", "skinny", "skintight", "skipper", "skipping", "skirmish", "skirt", "skittle", "skydiver", "skylight", "skyline", "skype", "skyrocket", "skyward", "slab", "slacked", "slacker", "slacking", "slackness", "slacks", "slain", "slam", "slander", "slang", "slapping", "slapstick", "slashed", "slashing", "slate", "slather", "slaw", "sled", "sleek", "sleep", "sleet", "sleeve", "slept", "sliceable", "sliced", "slicer", "slicing", "slick", "slider", "slideshow", "sliding", "slighted", "slighting", "slightly", "slimness", "slimy", "slinging", "slingshot", "slinky", "slip", "slit", "sliver", "slobbery", "slogan", "sloped", "sloping", "sloppily", "sloppy", "slot", "slouching", "slouchy", "sludge", "slug", "slum", "slurp", "slush", "sly", "small", "smartly", "smartness", "smasher", "smashing", "smashup", "smell", "smelting", "smile", "smilingly", "smirk", "smite", "smith", "smitten", "smock", "smog", "smoked", "smokeless", "smokiness", "smoking", "smoky", "smolder", "smooth", "smother", "smudge", "smudgy", "smuggler", "smuggling", "smugly", "smugness", "snack", "snagged", "snaking", "snap", "snare", "snarl", "snazzy", "sneak", "sneer", "sneeze", "sneezing", "snide", "sniff", "snippet", "snipping", "snitch", "snooper", "snooze", "snore", "snoring", "snorkel", "snort", "snout", "snowbird", "snowboard", "snowbound", "snowcap", "snowdrift", "snowdrop", "snowfall", "snowfield", "snowflake", "snowiness", "snowless", "snowman", "snowplow", "snowshoe", "snowstorm", "snowsuit", "snowy", "snub", "snuff", "snuggle", "snugly", "snugness", "speak", "spearfish", "spearhead", "spearman", "spearmint", "species", "specimen", "specked", "speckled", "specks", "spectacle", "spectator", "spectrum", "speculate", "speech", "speed", "spellbind", "speller", "spelling", "spendable", "spender", "spending", "spent", "spew", "sphere", "spherical", "sphinx", "spider", "spied", "spiffy", "spill", "spilt", "spinach", "spinal", "spindle", "spinner", "spinning", "spinout", "spinster", "spiny", "spiral", "spirited", "spiritism", "spirits", "spiritual", "splashed", "splashing", "splashy", "splatter", "spleen", "splendid", "splendor", "splice", "splicing", "splinter", "splotchy", "splurge", "spoilage", "spoiled", "spoiler", "spoiling", "spoils", "spoken", "spokesman", "sponge", "spongy", "sponsor", "spoof", "spookily", "spooky", "spool", "spoon", "spore", "sporting", "sports", "sporty", "spotless", "spotlight", "spotted", "spotter", "spotting", "spotty", "spousal", "spouse", "spout", "sprain", "sprang", "sprawl", "spray", "spree", "sprig", "spring", "sprinkled", "sprinkler", "sprint", "sprite", "sprout", "spruce", "sprung", "spry", "spud", "spur", "sputter", "spyglass", "squabble", "squad", "squall", "squander", "squash", "squatted", "squatter", "squatting", "squeak", "squealer", "squealing", "squeamish", "squeegee", "squeeze", "squeezing", "squid", "squiggle", "squiggly", "squint", "squire", "squirt", "squishier", "squishy", "stability", "stabilize", "stable", "stack", "stadium", "staff", "stage", "staging", "stagnant", "stagnate", "stainable", "stained", "staining", "stainless", "stalemate", "staleness", "stalling", "stallion", "stamina", "stammer", "stamp", "stand", "stank", "staple", "stapling", "starboard", "starch", "stardom", "stardust", "starfish", "stargazer", "staring", "stark", "starless", "starlet", "starlight", "starlit", "starring", "starry", "starship", "starter", "starting", "startle", "startling", "startup", "starved", "starving", "stash", "state", "static", "statistic", "statue", "stature", "status", "statute", "statutory", "staunch", "stays", "steadfast", "steadier", "steadily", "steadying", "steam", "steed", "steep", "steerable", "steering", "steersman", "stegosaur", "stellar", "stem", "stench", "stencil", "step", "stereo", "sterile", "sterility", "sterilize", "sterling", "sternness", "sternum", "stew", "stick", "stiffen", "stiffly", "stiffness", "stifle", "stifling", "stillness", "stilt", "stimulant", "stimulate", "stimuli", "stimulus", "stinger", "stingily", "stinging", "stingray", "stingy", "stinking", "stinky", "stipend", "stipulate", "stir", "stitch", "stock", "stoic", "stoke", "stole", "stomp", "stonewall", "stoneware", "stonework", "stoning", "stony", "stood", "stooge", "stool", "stoop", "stoplight", "stoppable", "stoppage", "stopped", "stopper", "stopping", "stopwatch", "storable", "storage", "storeroom", "storewide", "storm", "stout", "stove", "stowaway", "stowing", "straddle", "straggler", "strained", "strainer", "straining", "strangely", "stranger", "strangle", "strategic", "strategy", "stratus", "straw", "stray", "streak", "stream", "street", "strength", "strenuous", "strep", "stress", "stretch", "strewn", "stricken", "strict", "stride", "strife", "strike", "striking", "strive", "striving", "strobe", "strode", "stroller", "strongbox", "strongly", "strongman", "struck", "structure", "strudel", "struggle", "strum", "strung", "strut", "stubbed", "stubble", "stubbly", "stubborn", "stucco", "stuck", "student", "studied", "studio", "study", "stuffed", "stuffing", "stuffy", "stumble", "stumbling", "stump", "stung", "stunned", "stunner", "stunning", "stunt", "stupor", "sturdily", "sturdy", "styling", "stylishly", "stylist", "stylized", "stylus", "suave", "subarctic", "subatomic", "subdivide", "subdued", "subduing", "subfloor", "subgroup", "subheader", "subject", "sublease", "sublet", "sublevel", "sublime", "submarine", "submerge", "submersed", "submitter", "subpanel", "subpar", "subplot", "subprime", "subscribe", "subscript", "subsector", "subside", "subsiding", "subsidize", "subsidy", "subsoil", "subsonic", "substance", "subsystem", "subtext", "subtitle", "subtly", "subtotal", "subtract", "subtype", "suburb", "subway", "subwoofer", "subzero", "succulent", "such", "suction", "sudden", "sudoku", "suds", "sufferer", "suffering", "suffice", "suffix", "suffocate", "suffrage", "sugar", "suggest", "suing", "suitable", "suitably", "suitcase", "suitor", "sulfate", "sulfide", "sulfite", "sulfur", "sulk", "sullen", "sulphate", "sulphuric", "sultry", "superbowl", "superglue", "superhero", "superior", "superjet", "superman", "supermom", "supernova", "supervise", "supper", "supplier", "supply", "support", "supremacy", "supreme", "surcharge", "surely", "sureness", "surface", "surfacing", "surfboard", "surfer", "surgery", "surgical", "surging", "surname", "surpass", "surplus", "surprise", "surreal", "surrender", "surrogate", "surround", "survey", "survival", "survive", "surviving", "survivor", "sushi", "suspect", "suspend", "suspense", "sustained", "sustainer", "swab", "swaddling", "swagger", "swampland", "swan", "swapping", "swarm", "sway", "swear", "sweat", "sweep", "swell", "swept", "swerve", "swifter", "swiftly", "swiftness", "swimmable", "swimmer", "swimming", "swimsuit", "swimwear", "swinger", "swinging", "swipe", "swirl", "switch", "swivel", "swizzle", "swooned", "swoop", "swoosh", "swore", "sworn", "swung", "sycamore", "sympathy", "symphonic", "symphony", "symptom", "synapse", "syndrome", "synergy", "synopses", "synopsis", "synthesis", "synthetic", "syrup", "system", "t-shirt", "tabasco", "tabby", "tableful", "tables", "tablet", "tableware", "tabloid", "tackiness", "tacking", "tackle", "tackling", "tacky", "taco", "tactful", "tactical", "tactics", "tactile", "tactless", "tadpole", "taekwondo", "tag", "tainted", "take", "taking", "talcum", "talisman", "tall", "talon", "tamale", "tameness", "tamer", "tamper", "tank", "tanned", "tannery", "tanning", "tantrum", "tapeless", "tapered", "tapering", "tapestry", "tapioca", "tapping", "taps", "tarantula", "target", "tarmac", "tarnish", "tarot", "tartar", "tartly", "tartness", "task", "tassel", "taste", "tastiness", "tasting", "tasty", "tattered", "tattle", "tattling", "tattoo", "taunt", "tavern", "thank", "that", "thaw", "theater", "theatrics", "thee", "theft", "theme", "theology", "theorize", "thermal", "thermos", "thesaurus", "these", "thesis", "thespian", "thicken", "thicket", "thickness", "thieving", "thievish", "thigh", "thimble", "thing", "think", "thinly", "thinner", "thinness", "thinning", "thirstily", "thirsting", "thirsty", "thirteen", "thirty", "thong", "thorn", "those", "thousand", "thrash", "thread", "threaten", "threefold", "thrift", "thrill", "thrive", "thriving", "throat", "throbbing", "throng", "throttle", "throwaway", "throwback", "thrower", "throwing", "thud", "thumb", "thumping", "thursday", "thus", "thwarting", "thyself", "tiara", "tibia", "tidal", "tidbit", "tidiness", "tidings", "tidy", "tiger", "tighten", "tightly", "tightness", "tightrope", "tightwad", "tigress", "tile", "tiling", "till", "tilt", "timid", "timing", "timothy", "tinderbox", "tinfoil", "tingle", "tingling", "tingly", "tinker", "tinkling", "tinsel", "tinsmith", "tint", "tinwork", "tiny", "tipoff", "tipped", "tipper", "tipping", "tiptoeing", "tiptop", "tiring", "tissue", "trace", "tracing", "track", "traction", "tractor", "trade", "trading", "tradition", "traffic", "tragedy", "trailing", "trailside", "train", "traitor", "trance", "tranquil", "transfer", "transform", "translate", "transpire", "transport", "transpose", "trapdoor", "trapeze", "trapezoid", "trapped", "trapper", "trapping", "traps", "trash", "travel", "traverse", "travesty", "tray", "treachery", "treading", "treadmill", "treason", "treat", "treble", "tree", "trekker", "tremble", "trembling", "tremor", "trench", "trend", "trespass", "triage", "trial", "triangle", "tribesman", "tribunal", "tribune", "tributary", "tribute", "triceps", "trickery", "trickily", "tricking", "trickle", "trickster", "tricky", "tricolor", "tricycle", "trident", "tried", "trifle", "trifocals", "trillion", "trilogy", "trimester", "trimmer", "trimming", "trimness", "trinity", "trio", "tripod", "tripping", "triumph", "trivial", "trodden", "trolling", "trombone", "trophy", "tropical", "tropics", "trouble", "troubling", "trough", "trousers", "trout", "trowel", "truce", "truck", "truffle", "trump", "trunks", "trustable", "trustee", "trustful", "trusting", "trustless", "truth", "try", "tubby", "tubeless", "tubular", "tucking", "tuesday", "tug", "tuition", "tulip", "tumble", "tumbling", "tummy", "turban", "turbine", "turbofan", "turbojet", "turbulent", "turf", "turkey", "turmoil", "turret", "turtle", "tusk", "tutor", "tutu", "tux", "tweak", "tweed", "tweet", "tweezers", "twelve", "twentieth", "twenty", "twerp", "twice", "twiddle", "twiddling", "twig", "twilight", "twine", "twins", "twirl", "twistable", "twisted", "twister", "twisting", "twisty", "twitch", "twitter", "tycoon", "tying", "tyke", "udder", "ultimate", "ultimatum", "ultra", "umbilical", "umbrella", "umpire", "unabashed", "unable", "unadorned", "unadvised", "unafraid", "unaired", "unaligned", "unaltered", "unarmored", "unashamed", "unaudited", "unawake", "unaware", "unbaked", "unbalance", "unbeaten", "unbend", "unbent", "unbiased", "unbitten", "unblended", "unblessed", "unblock", "unbolted", "unbounded", "unboxed", "unbraided", "unbridle", "unbroken", "unbuckled", "unbundle", "unburned", "unbutton", "uncanny", "uncapped", "uncaring", "uncertain", "unchain", "unchanged", "uncharted", "uncheck", "uncivil", "unclad", "unclaimed", "unclamped", "unclasp", "uncle", "unclip", "uncloak", "unclog", "unclothed", "uncoated", "uncoiled", "uncolored", "uncombed", "uncommon", "uncooked", "uncork", "uncorrupt", "uncounted", "uncouple", "uncouth", "uncover", "uncross", "uncrown", "uncrushed", "uncured", "uncurious", "uncurled", "uncut", "undamaged", "undated", "undaunted", "undead", "undecided", "undefined", "underage", "underarm", "undercoat", "undercook", "undercut", "underdog", "underdone", "underfed", "underfeed", "underfoot", "undergo", "undergrad", "underhand", "underline", "underling", "undermine", "undermost", "underpaid", "underpass", "underpay", "underrate", "undertake", "undertone", "undertook", "undertow", "underuse", "underwear", "underwent", "underwire", "undesired", "undiluted", "undivided", "undocked", "undoing", "undone", "undrafted", "undress", "undrilled", "undusted", "undying", "unearned", "unearth", "unease", "uneasily", "uneasy", "uneatable", "uneaten", "unedited", "unelected", "unending", "unengaged", "unenvied", "unequal", "unethical", "uneven", "unexpired", "unexposed", "unfailing", "unfair", "unfasten", "unfazed", "unfeeling", "unfiled", "unfilled", "unfitted", "unfitting", "unfixable", "unfixed", "unflawed", "unfocused", "unfold", "unfounded", "unframed", "unfreeze", "unfrosted", "unfrozen", "unfunded", "unglazed", "ungloved", "unglue", "ungodly", "ungraded", "ungreased", "unguarded", "unguided", "unhappily", "unhappy", "unharmed", "unhealthy", "unheard", "unhearing", "unheated", "unhelpful", "unhidden", "unhinge", "unhitched", "unholy", "unhook", "unicorn", "unicycle", "unified", "unifier", "uniformed", "uniformly", "unify", "unimpeded", "uninjured", "uninstall", "uninsured", "uninvited", "union", "uniquely", "unisexual", "unison", "unissued", "unit", "universal", "universe", "unjustly", "unkempt", "unkind", "unknotted", "unknowing", "unknown", "unlaced", "unlatch", "unlawful", "unleaded", "unlearned", "unleash", "unless", "unleveled", "unlighted", "unlikable", "unlimited", "unlined", "unlinked", "unlisted", "unlit", "unlivable", "unloaded", "unloader", "unlocked", "unlocking", "unlovable", "unloved", "unlovely", "unloving", "unluckily", "unlucky", "unmade", "unmanaged", "unmanned", "unmapped", "unmarked", "unmasked", "unmasking", "unmatched", "unmindful", "unmixable", "unmixed", "unmolded", "unmoral", "unmovable", "unmoved", "unmoving", "unnamable", "unnamed", "unnatural", "unneeded", "unnerve", "unnerving", "unnoticed", "unopened", "unopposed", "unpack", "unpadded", "unpaid", "unpainted", "unpaired", "unpaved", "unpeeled", "unpicked", "unpiloted", "unpinned", "unplanned", "unplanted", "unpleased", "unpledged", "unplowed", "unplug", "unpopular", "unproven", "unquote", "unranked", "unrated", "unraveled", "unreached", "unread", "unreal", "unreeling", "unrefined", "unrelated", "unrented", "unrest", "unretired", "unrevised", "unrigged", "unripe", "unrivaled", "unroasted", "unrobed", "unroll", "unruffled", "unruly", "unrushed", "unsaddle", "unsafe", "unsaid", "unsalted", "unsaved", "unsavory", "unscathed", "unscented", "unscrew", "unsealed", "unseated", "unsecured", "unseeing", "unseemly", "unseen", "unselect", "unselfish", "unsent", "unsettled", "unshackle", "unshaken", "unshaved", "unshaven", "unsheathe", "unshipped", "unsightly", "unsigned", "unskilled", "unsliced", "unsmooth", "unsnap", "unsocial", "unsoiled", "unsold", "unsolved", "unsorted", "unspoiled", "unspoken", "unstable", "unstaffed", "unstamped", "unsteady", "unsterile", "unstirred", "unstitch", "unstopped", "unstuck", "unstuffed", "unstylish", "unsubtle", "unsubtly", "unsuited", "unsure", "unsworn", "untagged", "untainted", "untaken", "untamed", "untangled", "untapped", "untaxed", "unthawed", "unthread", "untidy", "untie", "until", "untimed", "untimely", "untitled", "untoasted", "untold", "untouched", "untracked", "untrained", "untreated", "untried", "untrimmed", "untrue", "untruth", "unturned", "untwist", "untying", "unusable", "unused", "unusual", "unvalued", "unvaried", "unvarying", "unveiled", "unveiling", "unvented", "unviable", "unvisited", "unvocal", "unwanted", "unwarlike", "unwary", "unwashed", "unwatched", "unweave", "unwed", "unwelcome", "unwell", "unwieldy", "unwilling", "unwind", "unwired", "unwitting", "unwomanly", "unworldly", "unworn", "unworried", "unworthy", "unwound", "unwoven", "unwrapped", "unwritten", "unzip", "upbeat", "upchuck", "upcoming", "upcountry", "update", "upfront", "upgrade", "upheaval", "upheld", "uphill", "uphold", "uplifted", "uplifting", "upload", "upon", "upper", "upright", "uprising", "upriver", "uproar", "uproot", "upscale", "upside", "upstage", "upstairs", "upstart", "upstate", "upstream", "upstroke", "upswing", "uptake", "uptight", "uptown", "upturned", "upward", "upwind", "uranium", "urban", "urchin", "urethane", "urgency", "urgent", "urging", "urologist", "urology", "usable", "usage", "useable", "used", "uselessly", "user", "usher", "usual", "utensil", "utility", "utilize", "utmost", "utopia", "utter", "vacancy", "vacant", "vacate", "vacation", "vagabond", "vagrancy", "vagrantly", "vaguely", "vagueness", "valiant", "valid", "valium", "valley", "valuables", "value", "vanilla", "vanish", "vanity", "vanquish", "vantage", "vaporizer", "variable", "variably", "varied", "variety", "various", "varmint", "varnish", "varsity", "varying", "vascular", "vaseline", "vastly", "vastness", "veal", "vegan", "veggie", "vehicular", "velcro", "velocity", "velvet", "vendetta", "vending", "vendor", "veneering", "vengeful", "venomous", "ventricle", "venture", "venue", "venus", "verbalize", "verbally", "verbose", "verdict", "verify", "verse", "version", "versus", "vertebrae", "vertical", "vertigo", "very", "vessel", "vest", "veteran", "veto", "vexingly", "viability", "viable", "vibes", "vice", "vicinity", "victory", "video", "viewable", "viewer", "viewing", "viewless", "viewpoint", "vigorous", "village", "villain", "vindicate", "vineyard", "vintage", "violate", "violation", "violator", "violet", "violin", "viper", "viral", "virtual", "virtuous", "virus", "visa", "viscosity", "viscous", "viselike", "visible", "visibly", "vision", "visiting", "visitor", "visor", "vista", "vitality", "vitalize", "vitally", "vitamins", "vivacious", "vividly", "vividness", "vixen", "vocalist", "vocalize", "vocally", "vocation", "voice", "voicing", "void", "volatile", "volley", "voltage", "volumes", "voter", "voting", "voucher", "vowed", "vowel", "voyage", "wackiness", "wad", "wafer", "waffle", "waged", "wager", "wages", "waggle", "wagon", "wake", "waking", "walk", "walmart", "walnut", "walrus", "waltz", "wand", "wannabe", "wanted", "wanting", "wasabi", "washable", "washbasin", "washboard", "washbowl", "washcloth", "washday", "washed", "washer", "washhouse", "washing", "washout", "washroom", "washstand", "washtub", "wasp", "wasting", "watch", "water", "waviness", "waving", "wavy", "whacking", "whacky", "wham", "wharf", "wheat", "whenever", "whiff", "whimsical", "whinny", "whiny", "whisking", "whoever", "whole", "whomever", "whoopee", "whooping", "whoops", "why", "wick", "widely", "widen", "widget", "widow", "width", "wieldable", "wielder", "wife", "wifi", "wikipedia", "wildcard", "wildcat", "wilder", "wildfire", "wildfowl", "wildland", "wildlife", "wildly", "wildness", "willed", "willfully", "willing", "willow", "willpower", "wilt", "wimp", "wince", "wincing", "wind", "wing", "winking", "winner", "winnings", "winter", "wipe", "wired", "wireless", "wiring", "wiry", "wisdom", "wise", "wish", "wisplike", "wispy", "wistful", "wizard", "wobble", "wobbling", "wobbly", "wok", "wolf", "wolverine", "womanhood", "womankind", "womanless", "womanlike", "womanly", "womb", "woof", "wooing", "wool", "woozy", "word", "work", "worried", "worrier", "worrisome", "worry", "worsening", "worshiper", "worst", "wound", "woven", "wow", "wrangle", "wrath", "wreath", "wreckage", "wrecker", "wrecking", "wrench", "wriggle", "wriggly", "wrinkle", "wrinkly", "wrist", "writing", "written", "wrongdoer", "wronged", "wrongful", "wrongly", "wrongness", "wrought", "xbox", "xerox", "yahoo", "yam", "yanking", "yapping", "yard", "yarn", "yeah", "yearbook", "yearling", "yearly", "yearning", "yeast", "yelling", "yelp", "yen", "yesterday", "yiddish", "yield", "yin", "yippee", "yo-yo", "yodel", "yoga", "yogurt", "yonder", "yoyo", "yummy", "zap", "zealous", "zebra", "zen", "zeppelin", "zero", "zestfully", "zesty", "zigzagged", "zipfile", "zipping", "zippy", "zips", "zit", "zodiac", "zombie", "zone", "zoning", "zookeeper", "zoologist", "zoology", "zoom"},
+	"eff_short": {"aardvark", "abandoned", "abbreviate", "abdomen", "abhorrence", "abiding", "abnormal", "abrasion", "absorbing", "abundant", "abyss", "academy", "accountant", "acetone", "achiness", "acid", "acoustics", "acquire", "acrobat", "actress", "acuteness", "aerosol", "aesthetic", "affidavit", "afloat", "afraid", "aftershave", "again", "agency", "aggressor", "aghast", "agitate", "agnostic", "agonizing", "agreeing", "aidless", "aimlessly", "ajar", "alarmclock", "albatross", "alchemy", "alfalfa", "algae", "aliens", "alkaline", "almanac", "alongside", "alphabet", "already", "also", "altitude", "aluminum", "always", "amazingly", "ambulance", "amendment", "amiable", "ammunition", "amnesty", "amoeba", "amplifier", "amuser", "anagram", "anchor", "android", "anesthesia", "angelfish", "animal", "anklet", "announcer", "anonymous", "answer", "antelope", "anxiety", "anyplace", "aorta", "apartment", "apnea", "apostrophe", "apple", "apricot", "aquamarine", "arachnid", "arbitrate", "ardently", "arena", "argument", "aristocrat", "armchair", "aromatic", "arrowhead", "arsonist", "artichoke", "asbestos", "ascend", "aseptic", "ashamed", "asinine", "asleep", "asocial", "asparagus", "astronaut", "asymmetric", "atlas", "atmosphere", "atom", "atrocious", "attic", "atypical", "auctioneer", "auditorium", "augmented", "auspicious", "automobile", "auxiliary", "avalanche", "avenue", "aviator", "avocado", "awareness", "awhile", "awkward", "awning", "awoke", "axially", "azalea", "babbling", "backpack", "badass", "bagpipe", "bakery", "balancing", "bamboo", "banana", "barracuda", "basket", "bathrobe", "bazooka", "blade", "blender", "blimp", "blouse", "blurred", "boatyard", "bobcat", "body", "bogusness", "bohemian", "boiler", "bonnet", "boots", "borough", "bossiness", "bottle", "bouquet", "boxlike", "breath", "briefcase", "broom", "brushes", "bubblegum", "buckle", "buddhist", "buffalo", "bullfrog", "bunny", "busboy", "buzzard", "cabin", "cactus", "cadillac", "cafeteria", "cage", "cahoots", "cajoling", "cakewalk", "calculator", "camera", "canister", "capsule", "carrot", "cashew", "cathedral", "caucasian", "caviar", "ceasefire", "cedar", "celery", "cement", "census", "ceramics", "cesspool", "chalkboard", "cheesecake", "chimney", "chlorine", "chopsticks", "chrome", "chute", "cilantro", "cinnamon", "circle", "cityscape", "civilian", "clay", "clergyman", "clipboard", "clock", "clubhouse", "coathanger", "cobweb", "coconut", "codeword", "coexistent", "coffeecake", "cognitive", "cohabitate", "collarbone", "computer", "confetti", "copier", "cornea", "cosmetics", "cotton", "couch", "coverless", "coyote", "coziness", "crawfish", "crewmember", "crib", "croissant", "crumble", "crystal", "cubical", "cucumber", "cuddly", "cufflink", "cuisine", "culprit", "cup", "curry", "cushion", "cuticle", "cybernetic", "cyclist", "cylinder", "cymbal", "cynicism", "cypress", "cytoplasm", "dachshund", "daffodil", "dagger", "dairy", "dalmatian", "dandelion", "dartboard", "dastardly", "datebook", "daughter", "dawn", "daytime", "dazzler", "dealer", "debris", "decal", "dedicate", "deepness", "defrost", "degree", "dehydrator", "deliverer", "democrat", "dentist", "deodorant", "depot", "deranged", "desktop", "detergent", "device", "dexterity", "diamond", "dibs", "dictionary", "diffuser", "digit", "dilated", "dimple", "dinnerware", "dioxide", "diploma", "directory", "dishcloth", "ditto", "dividers", "dizziness", "doctor", "dodge", "doll", "dominoes", "donut", "doorstep", "dorsal", "double", "downstairs", "dozed", "drainpipe", "dresser", "driftwood", "droppings", "drum", "dryer", "dubiously", "duckling", "duffel", "dugout", "dumpster", "duplex", "durable", "dustpan", "dutiful", "duvet", "dwarfism", "dwelling", "dwindling", "dynamite", "dyslexia", "eagerness", "earlobe", "easel", "eavesdrop", "ebook", "eccentric", "echoless", "eclipse", "ecosystem", "ecstasy", "edged", "editor", "educator", "eelworm", "eerie", "effects", "eggnog", "egomaniac", "ejection", "elastic", "elbow", "elderly", "elephant", "elfishly", "eliminator", "elk", "elliptical", "elongated", "elsewhere", "elusive", "elves", "emancipate", "embroidery", "emcee", "emerald", "emission", "emoticon", "emperor", "emulate", "enactment", "enchilada", "endorphin", "energy", "enforcer", "engine", "enhance", "enigmatic", "enjoyably", "enlarged", "enormous", "enquirer", "enrollment", "ensemble", "entryway", "enunciate", "envoy", "enzyme", "epidemic", "equipment", "erasable", "ergonomic", "erratic", "eruption", "escalator", "eskimo", "esophagus", "espresso", "essay", "estrogen", "etching", "eternal", "ethics", "etiquette", "eucalyptus", "eulogy", "euphemism", "euthanize", "evacuation", "evergreen", "evidence", "evolution", "exam", "excerpt", "exerciser", "exfoliate", "exhale", "exist", "exorcist", "explode", "exquisite", "exterior", "exuberant", "fabric", "factory", "faded", "failsafe", "falcon", "family", "fanfare", "fasten", "faucet", "favorite", "feasibly", "february", "federal", "feedback", "feigned", "feline", "femur", "fence", "ferret", "festival", "fettuccine", "feudalist", "feverish", "fiberglass", "fictitious", "fiddle", "figurine", "fillet", "finalist", "fiscally", "fixture", "flashlight", "fleshiness", "flight", "florist", "flypaper", "foamless", "focus", "foggy", "folksong", "fondue", "footpath", "fossil", "fountain", "fox", "fragment", "freeway", "fridge", "frosting", "fruit", "fryingpan", "gadget", "gainfully", "gallstone", "gamekeeper", "gangway", "garlic", "gaslight", "gathering", "gauntlet", "gearbox", "gecko", "gem", "generator", "geographer", "gerbil", "gesture", "getaway", "geyser", "ghoulishly", "gibberish", "giddiness", "giftshop", "gigabyte", "gimmick", "giraffe", "giveaway", "gizmo", "glasses", "gleeful", "glisten", "glove", "glucose", "glycerin", "gnarly", "gnomish", "goatskin", "goggles", "goldfish", "gong", "gooey", "gorgeous", "gosling", "gothic", "gourmet", "governor", "grape", "greyhound", "grill", "groundhog", "grumbling", "guacamole", "guerrilla", "guitar", "gullible", "gumdrop", "gurgling", "gusto", "gutless", "gymnast", "gynecology", "gyration", "habitat", "hacking", "haggard", "haiku", "halogen", "hamburger", "handgun", "happiness", "hardhat", "hastily", "hatchling", "haughty", "hazelnut", "headband", "hedgehog", "hefty", "heinously", "helmet", "hemoglobin", "henceforth", "herbs", "hesitation", "hexagon", "hubcap", "huddling", "huff", "hugeness", "hullabaloo", "human", "hunter", "hurricane", "hushing", "hyacinth", "hybrid", "hydrant", "hygienist", "hypnotist", "ibuprofen", "icepack", "icing", "iconic", "identical", "idiocy", "idly", "igloo", "ignition", "iguana", "illuminate", "imaging", "imbecile", "imitator", "immigrant", "imprint", "iodine", "ionosphere", "ipad", "iphone", "iridescent", "irksome", "iron", "irrigation", "island", "isotope", "issueless", "italicize", "itemizer", "itinerary", "itunes", "ivory", "jabbering", "jackrabbit", "jaguar", "jailhouse", "jalapeno", "jamboree", "janitor", "jarring", "jasmine", "jaundice", "jawbreaker", "jaywalker", "jazz", "jealous", "jeep", "jelly", "jeopardize", "jersey", "jetski", "jezebel", "jiffy", "jigsaw", "jingling", "jobholder", "jockstrap", "jogging", "john", "joinable", "jokingly", "journal", "jovial", "joystick", "jubilant", "judiciary", "juggle", "juice", "jujitsu", "jukebox", "jumpiness", "junkyard", "juror", "justifying", "juvenile", "kabob", "kamikaze", "kangaroo", "karate", "kayak", "keepsake", "kennel", "kerosene", "ketchup", "khaki", "kickstand", "kilogram", "kimono", "kingdom", "kiosk", "kissing", "kite", "kleenex", "knapsack", "kneecap", "knickers", "koala", "krypton", "laboratory", "ladder", "lakefront", "lantern", "laptop", "laryngitis", "lasagna", "latch", "laundry", "lavender", "laxative", "lazybones", "lecturer", "leftover", "leggings", "leisure", "lemon", "length", "leopard", "leprechaun", "lettuce", "leukemia", "levers", "lewdness", "liability", "library", "licorice", "lifeboat", "lightbulb", "likewise", "lilac", "limousine", "lint", "lioness", "lipstick", "liquid", "listless", "litter", "liverwurst", "lizard", "llama", "luau", "lubricant", "lucidity", "ludicrous", "luggage", "lukewarm", "lullaby", "lumberjack", "lunchbox", "luridness", "luscious", "luxurious", "lyrics", "macaroni", "maestro", "magazine", "mahogany", "maimed", "majority", "makeover", "malformed", "mammal", "mango", "mapmaker", "marbles", "massager", "matchstick", "maverick", "maximum", "mayonnaise", "moaning", "mobilize", "moccasin", "modify", "moisture", "molecule", "momentum", "monastery", "moonshine", "mortuary", "mosquito", "motorcycle", "mousetrap", "movie", "mower", "mozzarella", "muckiness", "mudflow", "mugshot", "mule", "mummy", "mundane", "muppet", "mural", "mustard", "mutation", "myriad", "myspace", "myth", "nail", "namesake", "nanosecond", "napkin", "narrator", "nastiness", "natives", "nautically", "navigate", "nearest", "nebula", "nectar", "nefarious", "negotiator", "neither", "nemesis", "neoliberal", "nephew", "nervously", "nest", "netting", "neuron", "nevermore", "nextdoor", "nicotine", "niece", "nimbleness", "nintendo", "nirvana", "nuclear", "nugget", "nuisance", "nullify", "numbing", "nuptials", "nursery", "nutcracker", "nylon", "oasis", "oat", "obediently", "obituary", "object", "obliterate", "obnoxious", "observer", "obtain", "obvious", "occupation", "oceanic", "octopus", "ocular", "office", "oftentimes", "oiliness", "ointment", "older", "olympics", "omissible", "omnivorous", "oncoming", "onion", "onlooker", "onstage", "onward", "onyx", "oomph", "opaquely", "opera", "opium", "opossum", "opponent", "optical", "opulently", "oscillator", "osmosis", "ostrich", "otherwise", "ought", "outhouse", "ovation", "oven", "owlish", "oxford", "oxidize", "oxygen", "oyster", "ozone", "pacemaker", "padlock", "pageant", "pajamas", "palm", "pamphlet", "pantyhose", "paprika", "parakeet", "passport", "patio", "pauper", "pavement", "payphone", "pebble", "peculiarly", "pedometer", "pegboard", "pelican", "penguin", "peony", "pepperoni", "peroxide", "pesticide", "petroleum", "pewter", "pharmacy", "pheasant", "phonebook", "phrasing", "physician", "plank", "pledge", "plotted", "plug", "plywood", "pneumonia", "podiatrist", "poetic", "pogo", "poison", "poking", "policeman", "poncho", "popcorn", "porcupine", "postcard", "poultry", "powerboat", "prairie", "pretzel", "princess", "propeller", "prune", "pry", "pseudo", "psychopath", "publisher", "pucker", "pueblo", "pulley", "pumpkin", "punchbowl", "puppy", "purse", "pushup", "putt", "puzzle", "pyramid", "python", "quarters", "quesadilla", "quilt", "quote", "racoon", "radish", "ragweed", "railroad", "rampantly", "rancidity", "rarity", "raspberry", "ravishing", "rearrange", "rebuilt", "receipt", "reentry", "refinery", "register", "rehydrate", "reimburse", "rejoicing", "rekindle", "relic", "remote", "renovator", "reopen", "reporter", "request", "rerun", "reservoir", "retriever", "reunion", "revolver", "rewrite", "rhapsody", "rhetoric", "rhino", "rhubarb", "rhyme", "ribbon", "riches", "ridden", "rigidness", "rimmed", "riptide", "riskily", "ritzy", "riverboat", "roamer", "robe", "rocket", "romancer", "ropelike", "rotisserie", "roundtable", "royal", "rubber", "rudderless", "rugby", "ruined", "rulebook", "rummage", "running", "rupture", "rustproof", "sabotage", "sacrifice", "saddlebag", "saffron", "sainthood", "saltshaker", "samurai", "sandworm", "sapphire", "sardine", "sassy", "satchel", "sauna", "savage", "saxophone", "scarf", "scenario", "schoolbook", "scientist", "scooter", "scrapbook", "sculpture", "scythe", "secretary", "sedative", "segregator", "seismology", "selected", "semicolon", "senator", "septum", "sequence", "serpent", "sesame", "settler", "severely", "shack", "shelf", "shirt", "shovel", "shrimp", "shuttle", "shyness", "siamese", "sibling", "siesta", "silicon", "simmering", "singles", "sisterhood", "sitcom", "sixfold", "sizable", "skateboard", "skeleton", "skies", "skulk", "skylight", "slapping", "sled", "slingshot", "sloth", "slumbering", "smartphone", "smelliness", "smitten", "smokestack", "smudge", "snapshot", "sneezing", "sniff", "snowsuit", "snugness", "speakers", "sphinx", "spider", "splashing", "sponge", "sprout", "spur", "spyglass", "squirrel", "statue", "steamboat", "stingray", "stopwatch", "strawberry", "student", "stylus", "suave", "subway", "suction", "suds", "suffocate", "sugar", "suitcase", "sulphur", "superstore", "surfer", "sushi", "swan", "sweatshirt", "swimwear", "sword", "sycamore", "syllable", "symphony", "synagogue", "syringes", "systemize", "tablespoon", "taco", "tadpole", "taekwondo", "tagalong", "takeout", "tallness", "tamale", "tanned", "tapestry", "tarantula", "tastebud", "tattoo", "tavern", "thaw", "theater", "thimble", "thorn", "throat", "thumb", "thwarting", "tiara", "tidbit", "tiebreaker", "tiger", "timid", "tinsel", "tiptoeing", "tirade", "tissue", "tractor", "tree", "tripod", "trousers", "trucks", "tryout", "tubeless", "tuesday", "tugboat", "tulip", "tumbleweed", "tupperware", "turtle", "tusk", "tutorial", "tuxedo", "tweezers", "twins", "tyrannical", "ultrasound", "umbrella", "umpire", "unarmored", "unbuttoned", "uncle", "underwear", "unevenness", "unflavored", "ungloved", "unhinge", "unicycle", "unjustly", "unknown", "unlocking", "unmarked", "unnoticed", "unopened", "unpaved", "unquenched", "unroll", "unscrewing", "untied", "unusual", "unveiled", "unwrinkled", "unyielding", "unzip", "upbeat", "upcountry", "update", "upfront", "upgrade", "upholstery", "upkeep", "upload", "uppercut", "upright", "upstairs", "uptown", "upwind", "uranium", "urban", "urchin", "urethane", "urgent", "urologist", "username", "usher", "utensil", "utility", "utmost", "utopia", "utterance", "vacuum", "vagrancy", "valuables", "vanquished", "vaporizer", "varied", "vaseline", "vegetable", "vehicle", "velcro", "vendor", "vertebrae", "vestibule", "veteran", "vexingly", "vicinity", "videogame", "viewfinder", "vigilante", "village", "vinegar", "violin", "viperfish", "virus", "visor", "vitamins", "vivacious", "vixen", "vocalist", "vogue", "voicemail", "volleyball", "voucher", "voyage", "vulnerable", "waffle", "wagon", "wakeup", "walrus", "wanderer", "wasp", "water", "waving", "wheat", "whisper", "wholesaler", "wick", "widow", "wielder", "wifeless", "wikipedia", "wildcat", "windmill", "wipeout", "wired", "wishbone", "wizardry", "wobbliness", "wolverine", "womb", "woolworker", "workbasket", "wound", "wrangle", "wreckage", "wristwatch", "wrongdoing", "xerox", "xylophone", "yacht", "yahoo", "yard", "yearbook", "yesterday", "yiddish", "yield", "yo-yo", "yodel", "yogurt", "yuppie", "zealot", "zebra", "zeppelin", "zestfully", "zigzagged", "zillion", "zipping", "zirconium", "zodiac", "zombie", "zookeeper", "zucchini"},
+}
@@ -80,7 +80,11 @@ for i in "zxcvbn" \
 \
 "eheuczkqyq" \
 "rWibMFACxAUGZmxhVncy" \
-"Ba9ZyWABu99[BK#6MBgbH88Tofv)vs$w" ; do
+"Ba9ZyWABu99[BK#6MBgbH88Tofv)vs$w" \
+\
+"1|71|71|71|71|71|71|7" \
+"!1|7+\$5@4(<[{0%2986" \
+"p@\$\$w0rd1|7p@\$\$w0rd1|7p@\$\$w0rd1|7" ; do
   s=$(echo -n $i | sha256sum | awk '{print $1}')
   echo "$i" > ./workdir/corpus/$s.txt
 done
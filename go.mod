@@ -1 +1,5 @@
 module github.com/trustelem/zxcvbn
+
+go 1.18
+
+require golang.org/x/text v0.21.0
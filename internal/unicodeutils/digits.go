@@ -0,0 +1,66 @@
+package unicodeutils
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DigitValue returns the value of the decimal digit r (Unicode category Nd), or -1.
+func DigitValue(r rune) int {
+	if r >= '0' && r <= '9' {
+		return int(r - '0')
+	}
+	if !unicode.IsDigit(r) {
+		return -1
+	}
+	// decimal digits are encoded in runs of 0 to 9, some runs being contiguous
+	// (mathematical digits): find the start of the run to get the value.
+	zero := r
+	for unicode.IsDigit(zero - 1) {
+		zero--
+	}
+	return int(r-zero) % 10
+}
+
+// NormalizeDigits replaces the non-ASCII decimal digits of s (Arabic-Indic,
+// Devanagari, fullwidth, ...) by ASCII digits.
+// offsets maps the byte indexes of the result, and its length, to byte indexes of s.
+// It is nil when s has no non-ASCII digits, in which case s is returned as is.
+func NormalizeDigits(s string) (normalized string, offsets []int) {
+	if !hasNonASCIIDigit(s) {
+		return s, nil
+	}
+	var b strings.Builder
+	b.Grow(len(s))
+	offsets = make([]int, 0, len(s)+1)
+	for i, r := range s {
+		if r >= utf8.RuneSelf {
+			if v := DigitValue(r); v >= 0 {
+				b.WriteByte(byte('0' + v))
+				offsets = append(offsets, i)
+				continue
+			}
+		}
+		size := utf8.RuneLen(r)
+		if r == utf8.RuneError {
+			// invalid utf8 is copied byte per byte
+			_, size = utf8.DecodeRuneInString(s[i:])
+		}
+		b.WriteString(s[i : i+size])
+		for k := 0; k < size; k++ {
+			offsets = append(offsets, i+k)
+		}
+	}
+	offsets = append(offsets, len(s))
+	return b.String(), offsets
+}
+
+func hasNonASCIIDigit(s string) bool {
+	for _, r := range s {
+		if r >= utf8.RuneSelf && unicode.IsDigit(r) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,50 @@
+package unicodeutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DigitValue(t *testing.T) {
+	tests := []struct {
+		r    rune
+		want int
+	}{
+		{'0', 0},
+		{'7', 7},
+		{'a', -1},
+		{'٣', 3}, // Arabic-Indic
+		{'۹', 9}, // extended Arabic-Indic
+		{'४', 4}, // Devanagari
+		{'０', 0}, // fullwidth
+		{'８', 8},
+		{'𝟗', 9}, // mathematical bold
+		{'𝟘', 0}, // mathematical double-struck, right after the bold digits
+		{'½', -1},
+		{'²', -1},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, DigitValue(tt.r), "%q", tt.r)
+	}
+}
+
+func Test_NormalizeDigits(t *testing.T) {
+	normalized, offsets := NormalizeDigits("pass1234")
+	assert.Equal(t, "pass1234", normalized)
+	assert.Nil(t, offsets)
+
+	s := "é١٩٩١x"
+	normalized, offsets = NormalizeDigits(s)
+	assert.Equal(t, "é1991x", normalized)
+	assert.Equal(t, []int{0, 1, 2, 4, 6, 8, 10, 11}, offsets)
+	assert.Equal(t, "١٩٩١", s[offsets[2]:offsets[6]])
+
+	normalized, _ = NormalizeDigits("１３/０２/１９２１")
+	assert.Equal(t, "13/02/1921", normalized)
+
+	// invalid utf8 is kept
+	normalized, offsets = NormalizeDigits("\xff٣")
+	assert.Equal(t, "\xff3", normalized)
+	assert.Equal(t, []int{0, 1, 3}, offsets)
+}
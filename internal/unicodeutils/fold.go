@@ -0,0 +1,91 @@
+package unicodeutils
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// confusables maps letters that look like latin letters, and have no compatibility
+// decomposition, to those letters.
+var confusables = map[rune]string{
+	// Cyrillic
+	'а': "a", 'в': "b", 'е': "e", 'ё': "e", 'і': "i", 'ї': "i", 'ј': "j", 'к': "k",
+	'м': "m", 'н': "h", 'о': "o", 'р': "p", 'с': "c", 'т': "t", 'у': "y", 'х': "x",
+	'ѕ': "s", 'ԁ': "d", 'ԛ': "q", 'ԝ': "w", 'һ': "h", 'ӏ': "l", 'ү': "y",
+	'А': "A", 'В': "B", 'Е': "E", 'Ё': "E", 'І': "I", 'Ї': "I", 'Ј': "J", 'К': "K",
+	'М': "M", 'Н': "H", 'О': "O", 'Р': "P", 'С': "C", 'Т': "T", 'У': "Y", 'Х': "X",
+	'Ѕ': "S", 'Ԁ': "D", 'Ԛ': "Q", 'Ԝ': "W", 'Һ': "H", 'Ӏ': "I", 'Ү': "Y",
+	// Greek
+	'α': "a", 'β': "b", 'ε': "e", 'ι': "i", 'κ': "k", 'ν': "v", 'ο': "o", 'ρ': "p",
+	'τ': "t", 'υ': "u", 'χ': "x", 'ω': "w",
+	'Α': "A", 'Β': "B", 'Ε': "E", 'Ζ': "Z", 'Η': "H", 'Ι': "I", 'Κ': "K", 'Μ': "M",
+	'Ν': "N", 'Ο': "O", 'Ρ': "P", 'Τ': "T", 'Υ': "Y", 'Χ': "X",
+	// latin letters without decomposition
+	'ı': "i", 'ł': "l", 'Ł': "L", 'ø': "o", 'Ø': "O", 'đ': "d", 'Đ': "D", 'ħ': "h",
+	'Ħ': "H", 'ß': "ss", 'æ': "ae", 'Æ': "AE", 'œ': "oe", 'Œ': "OE", 'ɡ': "g",
+}
+
+// FoldRune returns r folded to the characters it stands for: its NFKC form
+// (fullwidth letters, ligatures), without diacritics, with confusable letters
+// (Cyrillic and Greek homoglyphs) replaced. Combining marks fold to "".
+func FoldRune(r rune) string {
+	if r < utf8.RuneSelf {
+		return string(r)
+	}
+	var b strings.Builder
+	for _, c := range norm.NFD.String(norm.NFKC.String(string(r))) {
+		if unicode.Is(unicode.Mn, c) {
+			continue
+		}
+		if s, ok := confusables[c]; ok {
+			b.WriteString(s)
+		} else {
+			b.WriteRune(c)
+		}
+	}
+	return b.String()
+}
+
+// Fold folds every rune of s with FoldRune.
+// offsets maps the byte indexes of the result, and its length, to byte indexes of s;
+// a folded rune is mapped to the start of the original rune.
+// It is nil when folding doesn't change s, in which case s is returned as is.
+// invalid utf8 strings aren't folded.
+func Fold(s string) (folded string, offsets []int) {
+	if !utf8.ValidString(s) {
+		return s, nil
+	}
+	var b strings.Builder
+	changed := false
+	for i, r := range s {
+		f := FoldRune(r)
+		if !changed {
+			if f == string(r) {
+				continue
+			}
+			changed = true
+			b.Grow(len(s))
+			b.WriteString(s[:i])
+			offsets = make([]int, i, len(s)+1)
+			for k := range offsets {
+				offsets[k] = k
+			}
+		}
+		b.WriteString(f)
+		for k := 0; k < len(f); k++ {
+			if f == string(r) {
+				offsets = append(offsets, i+k)
+			} else {
+				offsets = append(offsets, i)
+			}
+		}
+	}
+	if !changed {
+		return s, nil
+	}
+	offsets = append(offsets, len(s))
+	return b.String(), offsets
+}
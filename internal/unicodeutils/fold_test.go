@@ -0,0 +1,60 @@
+package unicodeutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_FoldRune(t *testing.T) {
+	tests := []struct {
+		r    rune
+		want string
+	}{
+		{'a', "a"},
+		{'ä', "a"},
+		{'É', "E"},
+		{'ｐ', "p"},
+		{'Ｐ', "P"},
+		{'ﬁ', "fi"},
+		{'о', "o"}, // Cyrillic
+		{'Ρ', "P"}, // Greek
+		{'ß', "ss"},
+		{'\u0308', ""}, // combining diaeresis
+		{'中', "中"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, FoldRune(tt.r), "%q", tt.r)
+	}
+}
+
+func Test_Fold(t *testing.T) {
+	folded, offsets := Fold("password")
+	assert.Equal(t, "password", folded)
+	assert.Nil(t, offsets)
+
+	s := "pässwörd"
+	folded, offsets = Fold(s)
+	assert.Equal(t, "password", folded)
+	assert.Equal(t, []int{0, 1, 3, 4, 5, 6, 8, 9, 10}, offsets)
+
+	folded, _ = Fold("ｐａｓｓｗｏｒｄ")
+	assert.Equal(t, "password", folded)
+
+	// combining marks are attached to the previous character
+	s = "pa\u0308ss"
+	folded, offsets = Fold(s)
+	assert.Equal(t, "pass", folded)
+	assert.Equal(t, "a\u0308", s[offsets[1]:offsets[2]])
+
+	// unchanged multi-byte characters keep their offsets
+	s = "中é"
+	folded, offsets = Fold(s)
+	assert.Equal(t, "中e", folded)
+	assert.Equal(t, []int{0, 1, 2, 3, 5}, offsets)
+
+	// invalid utf8
+	folded, offsets = Fold("\xffé")
+	assert.Equal(t, "\xffé", folded)
+	assert.Nil(t, offsets)
+}
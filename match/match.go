@@ -19,6 +19,26 @@ type Match struct {
 	DictionaryName      string            `json:"dictionary_name,omitempty"`
 	L33t                bool              `json:"l33t,omitempty"`
 	Sub                 map[string]string `json:"sub,omitempty"`
+	L33tCapped          bool              `json:"l33t_capped,omitempty"` // other substitutions from I were left untried
+	Folded              bool              `json:"folded,omitempty"`
+	Folds               map[string]string `json:"folds,omitempty"`
+	FoldVariations      float64           `json:"fold_variations,omitempty"`
+	Edits               []Edit            `json:"edits,omitempty"`
+	EditVariations      float64           `json:"edit_variations,omitempty"`
+	// Abbreviation is "vowels_dropped" or "phonetic" for abbreviated words
+	Abbreviation           string  `json:"abbreviation,omitempty"`
+	Ambiguity              int     `json:"ambiguity,omitempty"` // number of words with the same abbreviation, or T9 digits
+	AbbreviationVariations float64 `json:"abbreviation_variations,omitempty"`
+
+	// Keyboard layout (dictionary words typed in the wrong layout)
+	SourceLayout     string  `json:"source_layout,omitempty"`
+	TargetLayout     string  `json:"target_layout,omitempty"`
+	LayoutPairs      int     `json:"layout_pairs,omitempty"` // number of pairs of layouts tried
+	LayoutVariations float64 `json:"layout_variations,omitempty"`
+
+	// Passphrase (words joined by Separator; Rank is the number of words they are picked
+	// from, in the ranked dictionaries or in the word list DictionaryName of a generator)
+	Words []string `json:"words,omitempty"`
 
 	// Sequence
 	Graph         string `json:"graph,omitempty"`
@@ -28,11 +48,28 @@ type Match struct {
 	Turns         int    `json:"turns,omitempty"`
 	ShiftedCount  int    `json:"shifted_count,omitempty"`
 
+	// UnknownGraph is set on keyboard walks on a graph missing from adjacency.Graphs,
+	// which the scorer priced as bruteforce instead of with the graph
+	UnknownGraph bool `json:"unknown_graph,omitempty"`
+
+	// Spatial variants: walks skipping Gap keys at each step, pressing each key
+	// KeyRepeat times, or switching between Graphs, with their parts in BaseMatches
+	SpatialKind string   `json:"spatial_kind,omitempty"`
+	Gap         int      `json:"gap,omitempty"`
+	KeyRepeat   int      `json:"key_repeat,omitempty"`
+	Graphs      []string `json:"graphs,omitempty"`
+
 	// Repeat
 	BaseToken   string   `json:"base_token,omitempty"`
 	BaseGuesses float64  `json:"base_guesses,omitempty"`
 	BaseMatches []*Match `json:"base_matches,omitempty"`
 	RepeatCount int      `json:"repeat_count,omitempty"`
+	// RepeatVariants are the repetitions after BaseToken, for repeats of variants of it
+	RepeatVariants   []RepeatVariant `json:"repeat_variants,omitempty"`
+	RepeatVariations float64         `json:"repeat_variations,omitempty"`
+
+	// Palindrome (BaseToken, reversed after Pivot)
+	Pivot string `json:"pivot,omitempty"`
 
 	// Regexp
 	RegexName string `json:"regex_name,omitempty"`
@@ -42,10 +79,32 @@ type Match struct {
 	Month     int     `json:"month,omitempty"`
 	Day       int     `json:"day,omitempty"`
 	Separator string  `json:"separator,omitempty"`
+	MonthName string  `json:"month_name,omitempty"`
+	Weekday   string  `json:"weekday,omitempty"`
+	Ordinal   bool    `json:"ordinal,omitempty"`
+	Week      int     `json:"week,omitempty"` // ISO 8601 week dates use Day for the day of the week
+	TimeOfDay string  `json:"time_of_day,omitempty"`
 	Entropy   float64 `json:"entropy,omitempty"`
 	Guesses   float64 `json:"guesses,omitempty"`
 }
 
+// Edit is a typo turning a dictionary word into the token of a match.
+type Edit struct {
+	Operation string `json:"operation"` // "insertion", "deletion", "substitution" or "transposition"
+	Position  int    `json:"position"`  // index of the edit in the token
+	From      string `json:"from,omitempty"`
+	To        string `json:"to,omitempty"`
+}
+
+// RepeatVariant is a repetition of the base token of a repeat, with another case, l33t
+// substitutions or reversed.
+type RepeatVariant struct {
+	Token    string `json:"token"`
+	Case     bool   `json:"case,omitempty"`
+	L33tSubs int    `json:"l33t_subs,omitempty"` // number of substituted characters
+	Reversed bool   `json:"reversed,omitempty"`
+}
+
 type Matcher interface {
 	Matches(password string) []*Match
 }
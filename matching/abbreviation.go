@@ -0,0 +1,168 @@
+package matching
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/trustelem/zxcvbn/internal/mathutils"
+	"github.com/trustelem/zxcvbn/match"
+)
+
+// abbreviationMatch matches dictionary words written without their vowels ("psswrd",
+// "drgn", "mnky") and common phonetic abbreviations ("luv", "gr8", "thx").
+type abbreviationMatch struct {
+	dm dictionaryMatch
+}
+
+// minAbbreviationLength is the length of the shortest abbreviations matched: shorter
+// ones stand for too many words.
+const minAbbreviationLength = 3
+
+// phoneticAbbreviations maps common phonetic abbreviations to the words they stand for.
+var phoneticAbbreviations = map[string]string{
+	"2day":  "today",
+	"2moro": "tomorrow",
+	"2nite": "tonight",
+	"4eva":  "forever",
+	"4ever": "forever",
+	"boi":   "boy",
+	"coz":   "because",
+	"cuz":   "because",
+	"gr8":   "great",
+	"grl":   "girl",
+	"gurl":  "girl",
+	"h4x0r": "hacker",
+	"kewl":  "cool",
+	"kool":  "cool",
+	"l8r":   "later",
+	"lite":  "light",
+	"luv":   "love",
+	"luvr":  "lover",
+	"nite":  "night",
+	"pls":   "please",
+	"plz":   "please",
+	"sk8":   "skate",
+	"sk8r":  "skater",
+	"teh":   "the",
+	"thnx":  "thanks",
+	"tho":   "though",
+	"thru":  "through",
+	"thx":   "thanks",
+	"wat":   "what",
+	"wut":   "what",
+}
+
+// maxPhoneticAbbreviationLength is the length in bytes of the longest phonetic abbreviation.
+var maxPhoneticAbbreviationLength = func() int {
+	n := 0
+	for abbreviation := range phoneticAbbreviations {
+		n = mathutils.Max(n, len(abbreviation))
+	}
+	return n
+}()
+
+// skeleton returns word without its vowels, but a leading one: "password" is written
+// "psswrd" and "angel" "angl".
+func skeleton(word string) string {
+	var b strings.Builder
+	for i, r := range word {
+		if i > 0 && strings.ContainsRune("aeiou", r) {
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// skeletonCode indexes words by skeleton, if it is long enough and differs from the word.
+func skeletonCode(word string) (string, bool) {
+	s := skeleton(word)
+	return s, s != word && len(s) >= minAbbreviationLength
+}
+
+var (
+	defaultSkeletonsOnce sync.Once
+	defaultSkeletons     wordIndex
+)
+
+// defaultSkeletonIndex indexes the default dictionaries by skeleton. It is built on first use.
+func defaultSkeletonIndex() wordIndex {
+	defaultSkeletonsOnce.Do(func() {
+		defaultSkeletons = newWordIndex(defaultRankedDictionnaries.rankedDictionaries, skeletonCode)
+	})
+	return defaultSkeletons
+}
+
+func (am abbreviationMatch) Matches(password string) []*match.Match {
+	var matches []*match.Match
+	indexes := am.dm.wordIndexes(defaultSkeletonIndex, func(d *RankedDictionary) wordIndex { return d.skeletons }, skeletonCode)
+	names := make([]string, 0, len(am.dm.rankedDictionaries))
+	for name := range am.dm.rankedDictionaries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	maxLength := maxPhoneticAbbreviationLength
+	for _, index := range indexes {
+		maxLength = mathutils.Max(maxLength, index.maxCodeLength)
+	}
+
+	// the password is lower-cased once: offsets[r] and lowerOffsets[r] are the byte
+	// offsets of its r-th rune in password and lowerPassword.
+	var offsets, lowerOffsets []int
+	var b strings.Builder
+	for i, r := range password {
+		offsets = append(offsets, i)
+		lowerOffsets = append(lowerOffsets, b.Len())
+		b.WriteRune(unicode.ToLower(r))
+	}
+	offsets = append(offsets, len(password))
+	lowerOffsets = append(lowerOffsets, b.Len())
+	lowerPassword := b.String()
+
+	for a := range offsets {
+		for z := a + minAbbreviationLength; z < len(offsets) && lowerOffsets[z]-lowerOffsets[a] <= maxLength; z++ {
+			i, j := offsets[a], offsets[z]-1
+			token := password[i : j+1]
+			lower := lowerPassword[lowerOffsets[a]:lowerOffsets[z]]
+			for _, index := range indexes {
+				for _, e := range index.lookup(lower) {
+					matches = append(matches, &match.Match{
+						Pattern:        "dictionary",
+						I:              i,
+						J:              j,
+						Token:          token,
+						MatchedWord:    e.word,
+						Rank:           e.rank,
+						DictionaryName: e.dictionaryName,
+						Abbreviation:   "vowels_dropped",
+						Ambiguity:      e.ambiguity,
+					})
+				}
+			}
+			word, ok := phoneticAbbreviations[lower]
+			if !ok {
+				continue
+			}
+			for _, name := range names {
+				if rank, ok := am.dm.rankedDictionaries[name][word]; ok {
+					matches = append(matches, &match.Match{
+						Pattern:        "dictionary",
+						I:              i,
+						J:              j,
+						Token:          token,
+						MatchedWord:    word,
+						Rank:           rank,
+						DictionaryName: name,
+						Abbreviation:   "phonetic",
+						Ambiguity:      1,
+					})
+				}
+			}
+		}
+	}
+	match.Sort(matches)
+	return matches
+}
@@ -0,0 +1,124 @@
+package matching
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustelem/zxcvbn/match"
+)
+
+func Test_skeleton(t *testing.T) {
+	assert.Equal(t, "psswrd", skeleton("password"))
+	assert.Equal(t, "drgn", skeleton("dragon"))
+	assert.Equal(t, "angl", skeleton("angel"))
+	assert.Equal(t, "y", skeleton("you"))
+}
+
+func TestAbbreviationMatching(t *testing.T) {
+	dm := dictionaryMatch{
+		rankedDictionaries: map[string]rankedDictionnary{
+			"d1": buildRankedDict([]string{"password", "dragon", "love", "drugan", "go"}),
+			"d2": buildRankedDict([]string{"monkey", "dragon"}),
+		},
+	}
+	am := abbreviationMatch{dm: dm}
+
+	for _, tt := range []struct {
+		password string
+		want     []*match.Match
+	}{
+		{
+			password: "PsswrD",
+			want: []*match.Match{{
+				Pattern: "dictionary", I: 0, J: 5, Token: "PsswrD",
+				MatchedWord: "password", Rank: 1, DictionaryName: "d1",
+				Abbreviation: "vowels_dropped", Ambiguity: 1,
+			}},
+		},
+		{
+			// "dragon" and "drugan" have the same skeleton in d1
+			password: "xdrgn",
+			want: []*match.Match{
+				{
+					Pattern: "dictionary", I: 1, J: 4, Token: "drgn",
+					MatchedWord: "dragon", Rank: 2, DictionaryName: "d1",
+					Abbreviation: "vowels_dropped", Ambiguity: 2,
+				},
+				{
+					Pattern: "dictionary", I: 1, J: 4, Token: "drgn",
+					MatchedWord: "dragon", Rank: 2, DictionaryName: "d2",
+					Abbreviation: "vowels_dropped", Ambiguity: 1,
+				},
+			},
+		},
+		{
+			password: "iluvmnky",
+			want: []*match.Match{
+				{
+					Pattern: "dictionary", I: 1, J: 3, Token: "luv",
+					MatchedWord: "love", Rank: 3, DictionaryName: "d1",
+					Abbreviation: "phonetic", Ambiguity: 1,
+				},
+				{
+					Pattern: "dictionary", I: 4, J: 7, Token: "mnky",
+					MatchedWord: "monkey", Rank: 1, DictionaryName: "d2",
+					Abbreviation: "vowels_dropped", Ambiguity: 1,
+				},
+			},
+		},
+		// skeletons shorter than 3 letters and words without vowels are not matched
+		{password: "g"},
+		{password: "passwrd"},
+	} {
+		assert.Equal(t, tt.want, am.Matches(tt.password), tt.password)
+	}
+
+	// tokens are no longer than the longest skeleton or phonetic abbreviation
+	assert.Equal(t, 6, newWordIndex(dm.rankedDictionaries, skeletonCode).maxCodeLength)
+	matches := am.Matches("ÉÉÉÉÉÉÉÉÉÉÉÉÉÉÉÉÉÉÉÉÉÉÉÉÉÉÉÉÉÉÉÉÉÉpsswrd")
+	if assert.Len(t, matches, 1) {
+		assert.Equal(t, "psswrd", matches[0].Token)
+		assert.Equal(t, 68, matches[0].I)
+	}
+}
+
+func TestAbbreviationMatchingUserInputs(t *testing.T) {
+	am := abbreviationMatch{dm: defaultRankedDictionnaries.withDict("user_inputs", buildRankedDict([]string{"trustelem"}))}
+	var found bool
+	for _, m := range am.Matches("trstlm") {
+		if m.DictionaryName == "user_inputs" {
+			found = true
+			assert.Equal(t, "trustelem", m.MatchedWord)
+		}
+	}
+	assert.True(t, found)
+	for _, m := range am.Matches("drgn") {
+		assert.NotEqual(t, "user_inputs", m.DictionaryName)
+	}
+}
+
+func TestAbbreviationMatchingDictionaries(t *testing.T) {
+	d := NewRankedDictionary([]string{"zxcvbnpassword", "trustelem"})
+	dm := defaultRankedDictionnaries.withRankedDictionary("extra", d)
+	am := abbreviationMatch{dm: dm}
+	var found bool
+	for _, m := range am.Matches("zxcvbnpsswrd") {
+		if m.DictionaryName == "extra" {
+			found = true
+			assert.Equal(t, "zxcvbnpassword", m.MatchedWord)
+		}
+	}
+	assert.True(t, found)
+
+	// the indexes of the dictionaries are built once, with NewRankedDictionary
+	skeletons := func(d *RankedDictionary) wordIndex { return d.skeletons }
+	indexes := dm.wordIndexes(defaultSkeletonIndex, skeletons, skeletonCode)
+	if assert.Len(t, indexes, 2) {
+		assert.Equal(t, reflect.ValueOf(d.skeletons.codes).Pointer(), reflect.ValueOf(indexes[1].codes).Pointer())
+		assert.Equal(t, "extra", indexes[1].dictionaryName)
+	}
+	// and empty user inputs aren't indexed
+	indexes = dm.withDict("user_inputs", buildRankedDict(nil)).wordIndexes(defaultSkeletonIndex, skeletons, skeletonCode)
+	assert.Len(t, indexes, 2)
+}
@@ -1,17 +1,24 @@
 package matching
 
 import (
+	"sort"
 	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
 
-	"github.com/dlclark/regexp2"
 	"github.com/trustelem/zxcvbn/internal/mathutils"
 	"github.com/trustelem/zxcvbn/match"
-	"github.com/trustelem/zxcvbn/scoring"
 )
 
-const dateMaxYear = 2050
+// dateMinYear is the smallest number read as a 4-digit year.
 const dateMinYear = 1000
 
+// dateYearsAhead is how far after the reference year a date may be. Two-digit
+// years are expanded to the 100 years ending dateYearsAhead after the reference
+// year: with 2019 as reference, 51 is 1951 and 50 is 2050.
+const dateYearsAhead = 31
+
 var dateSplits = map[int][]struct{ k, l int }{
 	4: { // for length-4 strings, eg 1191 or 9111, two ways to split:
 		{1, 2}, // 1 1 91 (2nd split starts at index 1, 3rd at index 2)
@@ -38,10 +45,42 @@ var dateSplits = map[int][]struct{ k, l int }{
 	},
 }
 
-var maybeDateNoSeparator = regexp2.MustCompile(
-	`^\d{4,8}$`, 0)
-var maybeDateWithSeparator = regexp2.MustCompile(
-	`^(\d{1,4})([\s/\\_.-])(\d{1,2})\2(\d{1,4})$`, 0)
+// maybeDateNoSeparator reports whether token is 4 to 8 digits, like 1191 or 11111991.
+func maybeDateNoSeparator(token string) bool {
+	n := utf8.RuneCountInString(token)
+	return digitPrefix(token) == len(token) && n >= 4 && n <= 8
+}
+
+// maybeDateWithSeparator splits token into 1 to 4 digits, a separator, 1 or 2 digits,
+// the same separator again and 1 to 4 digits, like 1/1/91 or 11-11-1991.
+func maybeDateWithSeparator(token string) (s1, separator, s2, s3 string, ok bool) {
+	n1 := digitPrefix(token)
+	s1, token = token[:n1], token[n1:]
+	sep, size := utf8.DecodeRuneInString(token)
+	if size == 0 || !(unicode.IsSpace(sep) || strings.ContainsRune(`/\_.-`, sep)) {
+		return "", "", "", "", false
+	}
+	separator, token = token[:size], token[size:]
+	n2 := digitPrefix(token)
+	s2, token = token[:n2], token[n2:]
+	if !strings.HasPrefix(token, separator) {
+		return "", "", "", "", false
+	}
+	s3 = token[size:]
+	n1, n2, n3 := utf8.RuneCountInString(s1), utf8.RuneCountInString(s2), utf8.RuneCountInString(s3)
+	ok = digitPrefix(s3) == len(s3) && n1 >= 1 && n1 <= 4 && n2 >= 1 && n2 <= 2 && n3 >= 1 && n3 <= 4
+	return s1, separator, s2, s3, ok
+}
+
+// digitPrefix returns the length in bytes of the digits s starts with.
+func digitPrefix(s string) int {
+	for i, r := range s {
+		if !unicode.IsDigit(r) {
+			return i
+		}
+	}
+	return len(s)
+}
 
 // a "date" is recognized as:
 //   any 3-tuple that starts or ends with a 2- or 4-digit year,
@@ -54,13 +93,12 @@ var maybeDateWithSeparator = regexp2.MustCompile(
 // this doesn't check for leap years, etc.
 //
 // recipe:
-// start with regex to find maybe-dates, then attempt to map the integers
+// start with finding maybe-dates, then attempt to map the integers
 // onto month-day-year to filter the maybe-dates into dates.
 // finally, remove matches that are substrings of other matches to reduce noise.
 //
-// note: instead of using a lazy or greedy regex to find many dates over the full string,
-// this uses a ^...$ regex against every substring of the password -- less performant but leads
-// to every possible date match.
+// note: instead of searching for many dates over the full string, this checks every
+// substring of the password -- less performant but leads to every possible date match.
 
 type dateMatchCandidate struct {
 	Day   int
@@ -68,7 +106,16 @@ type dateMatchCandidate struct {
 	Year  int
 }
 
-type dateMatch struct{}
+type dateMatch struct {
+	referenceYear int
+	// names are the month and weekday names of textual dates, if enabled.
+	names []DateNames
+	// extended enables two-part dates, ISO 8601 dates and times of day.
+	extended bool
+	// monthNames[k] are the month names of names[k], sorted so that the readings of
+	// textual dates come in the same order from run to run. Set by textualMatches.
+	monthNames [][]string
+}
 
 func (dm dateMatch) Matches(password string) []*match.Match {
 	matches := []*match.Match{}
@@ -80,13 +127,13 @@ func (dm dateMatch) Matches(password string) []*match.Match {
 				break
 			}
 			token := password[i : j+1]
-			if m, err := maybeDateNoSeparator.MatchString(token); !m || err != nil {
+			if !maybeDateNoSeparator(token) {
 				continue
 			}
 			var candidates []*dateMatchCandidate
 			for _, s := range dateSplits[len(token)] {
 				s1, s2, s3 := token[0:s.k], token[s.k:s.l], token[s.l:]
-				if dmy := mapIntsToDMY(s1, s2, s3); dmy != nil {
+				if dmy := dm.mapIntsToDMY(s1, s2, s3); dmy != nil {
 					candidates = append(candidates, dmy)
 				}
 			}
@@ -94,15 +141,15 @@ func (dm dateMatch) Matches(password string) []*match.Match {
 				continue
 			}
 			// at this point: different possible dmy mappings for the same i,j substring.
-			// match the candidate date that likely takes the fewest guesses: a year closest to 2000.
-			// (scoring.REFERENCE_YEAR).
+			// match the candidate date that likely takes the fewest guesses: a year closest to
+			// the reference year.
 			//
 			// ie, considering '111504', prefer 11-15-04 to 1-1-1504
 			// (interpreting '04' as 2004)
 			bestCandidate := candidates[0]
-			minDistance := dateMatchMetric(candidates[0])
+			minDistance := dm.dateMatchMetric(candidates[0])
 			for _, candidate := range candidates[1:] {
-				distance := dateMatchMetric(candidate)
+				distance := dm.dateMatchMetric(candidate)
 				if distance < minDistance {
 					bestCandidate = candidate
 					minDistance = distance
@@ -129,23 +176,19 @@ func (dm dateMatch) Matches(password string) []*match.Match {
 				break
 			}
 			token := password[i : j+1]
-			m, err := maybeDateWithSeparator.FindStringMatch(token)
-			if m == nil || err != nil {
+			s1, separator, s2, s3, ok := maybeDateWithSeparator(token)
+			if !ok {
 				continue
 			}
 
-			dmy := mapIntsToDMY(
-				m.GroupByNumber(1).String(),
-				m.GroupByNumber(3).String(),
-				m.GroupByNumber(4).String(),
-			)
+			dmy := dm.mapIntsToDMY(s1, s2, s3)
 			if dmy != nil {
 				matches = append(matches, &match.Match{
 					Pattern:   "date",
 					Token:     token,
 					I:         i,
 					J:         j,
-					Separator: m.GroupByNumber(2).String(),
+					Separator: separator,
 					Year:      dmy.Year,
 					Month:     dmy.Month,
 					Day:       dmy.Day,
@@ -154,18 +197,26 @@ func (dm dateMatch) Matches(password string) []*match.Match {
 		}
 	}
 
+	if len(dm.names) > 0 {
+		matches = append(matches, dm.textualMatches(password)...)
+	}
+	if dm.extended {
+		matches = append(matches, dm.extendedMatches(password)...)
+	}
+
 	// matches now contains all valid date strings in a way that is tricky to capture
 	// with regexes only. while thorough, it will contain some unintuitive noise:
 	//
 	// '2015_06_04', in addition to matching 2015_06_04, will also contain
 	// 5(!) other date matches: 15_06_04, 5_06_04, ..., even 2015 (matched as 5/1/2020)
 	//
-	// to reduce noise, remove date matches that are strict substrings of others
+	// to reduce noise, remove date matches that are strict substrings of others.
+	// (different readings of the same token, such as "1230" as a date and as a time, are kept)
 	var filteredMatches []*match.Match
 	for _, m := range matches {
 		isSubmatch := false
 		for _, o := range matches {
-			if m == o {
+			if m == o || o.I == m.I && o.J == m.J {
 				continue
 			}
 			if o.I <= m.I && o.J >= m.J {
@@ -181,11 +232,16 @@ func (dm dateMatch) Matches(password string) []*match.Match {
 	return filteredMatches
 }
 
-func dateMatchMetric(c *dateMatchCandidate) int {
-	return mathutils.Abs(c.Year - scoring.ReferenceYear)
+func (dm dateMatch) dateMatchMetric(c *dateMatchCandidate) int {
+	return mathutils.Abs(c.Year - dm.referenceYear)
+}
+
+// maxYear returns the latest year a date can be in.
+func (dm dateMatch) maxYear() int {
+	return dm.referenceYear + dateYearsAhead
 }
 
-func mapIntsToDMY(s1, s2, s3 string) *dateMatchCandidate {
+func (dm dateMatch) mapIntsToDMY(s1, s2, s3 string) *dateMatchCandidate {
 	// given a 3-tuple, discard if:
 	//   middle int is over 31 (for all dmy formats, years are never allowed in the middle)
 	//   middle int is zero
@@ -194,6 +250,7 @@ func mapIntsToDMY(s1, s2, s3 string) *dateMatchCandidate {
 	//   2 ints are over 31, the max allowable day
 	//   2 ints are zero
 	//   all ints are over 12, the max allowable month
+	maxYear := dm.maxYear()
 	i1, _ := strconv.Atoi(s1)
 	i2, _ := strconv.Atoi(s2)
 	i3, _ := strconv.Atoi(s3)
@@ -204,7 +261,7 @@ func mapIntsToDMY(s1, s2, s3 string) *dateMatchCandidate {
 	over31 := 0
 	under1 := 0
 	for _, i := range [3]int{i1, i2, i3} {
-		if (i > 99 && i < dateMinYear) || i > dateMaxYear {
+		if (i > 99 && i < dateMinYear) || i > maxYear {
 			return nil
 		}
 		if i > 31 {
@@ -228,7 +285,7 @@ func mapIntsToDMY(s1, s2, s3 string) *dateMatchCandidate {
 	}
 	for _, split := range possibleYearSplits {
 		y := split[0]
-		if dateMinYear <= y && y <= dateMaxYear {
+		if dateMinYear <= y && y <= maxYear {
 			// for a candidate that includes a four-digit year,
 			// when the remaining ints don't match to a day and month,
 			// it is not a date.
@@ -242,7 +299,7 @@ func mapIntsToDMY(s1, s2, s3 string) *dateMatchCandidate {
 		y := split[0]
 		dm := mapIntsToDM(split[1], split[2], y)
 		if dm != nil {
-			dm.Year = twoToFourDigitYear(dm.Year)
+			dm.Year = twoToFourDigitYear(dm.Year, maxYear)
 			return dm
 		}
 	}
@@ -267,14 +324,196 @@ func mapIntsToDM(i1, i2 int, year int) *dateMatchCandidate {
 	return nil
 }
 
-func twoToFourDigitYear(year int) int {
+func twoToFourDigitYear(year int, maxYear int) int {
 	if year > 99 {
 		return year
-	} else if year > 50 {
-		// 87 -> 1987
-		return year + 1900
-	} else {
-		// 15 -> 2015
-		return year + 2000
 	}
+	// with maxYear 2050: 15 -> 2015, 87 -> 1987
+	year += maxYear / 100 * 100
+	if year > maxYear {
+		year -= 100
+	}
+	return year
+}
+
+// textual dates are recognised with the following layouts, where W is a weekday name,
+// M a month name, D a day maybe followed by an ordinal suffix and Y a 2- or 4-digit year.
+// the fields are separated by the same separator, maybe empty:
+// "14feb", "jan01", "1st-march-1987", "march1987", "friday13th", ...
+//
+// when a token has several readings, the first one in layout order is kept, so the one
+// with the fewest fields, unless a later reading has a year closer to the reference year.
+var textualDateLayouts = []string{
+	"MY", "YM", "MD", "DM", "WD",
+	"MDY", "DMY", "YMD", "WMD", "WDM",
+	"WMDY", "WDMY",
+}
+
+// minDateNameLength is the length of the shortest month and weekday names recognised.
+const minDateNameLength = 3
+
+var textualDateSeparators = []string{"", " ", "/", "\\", "_", ".", "-"}
+
+type textualDate struct {
+	dateMatchCandidate
+	i, j      int
+	separator string
+	monthName string
+	weekday   string
+	ordinal   bool
+}
+
+// dateField is one way to read a field of a textual date.
+type dateField struct {
+	end     int
+	value   int
+	text    string
+	ordinal bool
+}
+
+func (dm dateMatch) textualMatches(password string) []*match.Match {
+	dm.monthNames = make([][]string, len(dm.names))
+	for k, names := range dm.names {
+		for name := range names.Months {
+			dm.monthNames[k] = append(dm.monthNames[k], name)
+		}
+		sort.Strings(dm.monthNames[k])
+	}
+	var dates []textualDate
+	best := make(map[[2]int]int)
+	for i := range password {
+		for _, layout := range textualDateLayouts {
+			for _, d := range dm.readTextualDate(password, layout, 0, textualDate{i: i, j: i}) {
+				k, ok := best[[2]int{d.i, d.j}]
+				if !ok {
+					best[[2]int{d.i, d.j}] = len(dates)
+					dates = append(dates, d)
+				} else if d.Year != 0 && dates[k].Year != 0 &&
+					dm.dateMatchMetric(&d.dateMatchCandidate) < dm.dateMatchMetric(&dates[k].dateMatchCandidate) {
+					dates[k] = d
+				}
+			}
+		}
+	}
+	var matches []*match.Match
+	for _, d := range dates {
+		matches = append(matches, &match.Match{
+			Pattern:   "date",
+			Token:     password[d.i : d.j+1],
+			I:         d.i,
+			J:         d.j,
+			Separator: d.separator,
+			Year:      d.Year,
+			Month:     d.Month,
+			Day:       d.Day,
+			MonthName: d.monthName,
+			Weekday:   d.weekday,
+			Ordinal:   d.ordinal,
+		})
+	}
+	return matches
+}
+
+// readTextualDate reads the fields of layout after the first n ones, which are in d.
+// It returns every complete reading.
+func (dm dateMatch) readTextualDate(password string, layout string, n int, d textualDate) []textualDate {
+	if n == len(layout) {
+		d.j--
+		return []textualDate{d}
+	}
+	seps := []string{d.separator}
+	if n == 1 {
+		seps = textualDateSeparators
+	}
+	var dates []textualDate
+	for _, sep := range seps {
+		pos := d.j
+		if n > 0 {
+			if !strings.HasPrefix(password[pos:], sep) {
+				continue
+			}
+			pos += len(sep)
+		}
+		for _, f := range dm.readDateField(password[pos:], layout, layout[n]) {
+			next := d
+			next.separator = sep
+			next.j = pos + f.end
+			switch layout[n] {
+			case 'W':
+				next.weekday = f.text
+			case 'M':
+				next.Month = f.value
+				next.monthName = f.text
+			case 'D':
+				next.Day = f.value
+				next.ordinal = f.ordinal
+			case 'Y':
+				next.Year = f.value
+			}
+			dates = append(dates, dm.readTextualDate(password, layout, n+1, next)...)
+		}
+	}
+	return dates
+}
+
+// readDateField returns the possible readings of a field of kind k at the start of s.
+func (dm dateMatch) readDateField(s string, layout string, k byte) []dateField {
+	var fields []dateField
+	switch k {
+	case 'W':
+		for _, names := range dm.names {
+			for _, name := range names.Weekdays {
+				if utf8.RuneCountInString(name) >= minDateNameLength && hasPrefixFold(s, name) {
+					fields = append(fields, dateField{end: len(name), text: s[:len(name)]})
+				}
+			}
+		}
+	case 'M':
+		for k, names := range dm.names {
+			for _, name := range dm.monthNames[k] {
+				month := names.Months[name]
+				if utf8.RuneCountInString(name) >= minDateNameLength && hasPrefixFold(s, name) {
+					fields = append(fields, dateField{end: len(name), value: month, text: s[:len(name)]})
+				}
+			}
+		}
+	case 'D':
+		for l := 1; l <= 2 && l <= len(s) && isDigit(s[l-1]); l++ {
+			day, _ := strconv.Atoi(s[:l])
+			if day < 1 || day > 31 {
+				continue
+			}
+			fields = append(fields, dateField{end: l, value: day})
+			for _, names := range dm.names {
+				for _, suffix := range names.OrdinalSuffixes {
+					if hasPrefixFold(s[l:], suffix) {
+						fields = append(fields, dateField{end: l + len(suffix), value: day, ordinal: true})
+					}
+				}
+			}
+		}
+	case 'Y':
+		if len(s) >= 4 && isDigit(s[0]) && isDigit(s[1]) && isDigit(s[2]) && isDigit(s[3]) {
+			year, _ := strconv.Atoi(s[:4])
+			if dateMinYear <= year && year <= dm.maxYear() {
+				fields = append(fields, dateField{end: 4, value: year})
+			}
+		}
+		if len(s) >= 2 && isDigit(s[0]) && isDigit(s[1]) {
+			year, _ := strconv.Atoi(s[:2])
+			// without a day, a 2-digit number after or before a month is a day if it can be one
+			if year > 31 || strings.IndexByte(layout, 'D') >= 0 {
+				fields = append(fields, dateField{end: 2, value: twoToFourDigitYear(year, dm.maxYear())})
+			}
+		}
+	}
+	return fields
+}
+
+func hasPrefixFold(s, prefix string) bool {
+	return len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
 }
@@ -0,0 +1,125 @@
+package matching
+
+// DateNames lists the words recognised in textual dates, in lower case.
+// Month and weekday names shorter than 3 letters are ignored: they are found
+// inside too many words.
+type DateNames struct {
+	// Months maps month names and abbreviations to month numbers (1 to 12).
+	Months map[string]int
+	// Weekdays lists weekday names and abbreviations.
+	Weekdays []string
+	// OrdinalSuffixes lists the suffixes of ordinal day numbers ("1st", "22nd").
+	OrdinalSuffixes []string
+}
+
+// EnglishDateNames are the names used when textual dates are enabled without
+// any other names.
+var EnglishDateNames = DateNames{
+	Months: map[string]int{
+		"january": 1, "jan": 1,
+		"february": 2, "feb": 2,
+		"march": 3, "mar": 3,
+		"april": 4, "apr": 4,
+		"may":  5,
+		"june": 6, "jun": 6,
+		"july": 7, "jul": 7,
+		"august": 8, "aug": 8,
+		"september": 9, "sept": 9, "sep": 9,
+		"october": 10, "oct": 10,
+		"november": 11, "nov": 11,
+		"december": 12, "dec": 12,
+	},
+	Weekdays: []string{
+		"monday", "mon",
+		"tuesday", "tues", "tue",
+		"wednesday", "wed",
+		"thursday", "thurs", "thur", "thu",
+		"friday", "fri",
+		"saturday", "sat",
+		"sunday", "sun",
+	},
+	OrdinalSuffixes: []string{"st", "nd", "rd", "th"},
+}
+
+// FrenchDateNames, GermanDateNames and SpanishDateNames are localised names
+// that can be added to Options.DateNames.
+var FrenchDateNames = DateNames{
+	Months: map[string]int{
+		"janvier": 1, "janv": 1,
+		"février": 2, "fevrier": 2, "févr": 2, "fevr": 2,
+		"mars":  3,
+		"avril": 4, "avr": 4,
+		"mai":     5,
+		"juin":    6,
+		"juillet": 7, "juil": 7,
+		"août": 8, "aout": 8,
+		"septembre": 9, "sept": 9,
+		"octobre": 10, "oct": 10,
+		"novembre": 11, "nov": 11,
+		"décembre": 12, "decembre": 12, "déc": 12, "dec": 12,
+	},
+	Weekdays: []string{
+		"lundi", "lun",
+		"mardi", "mar",
+		"mercredi", "mer",
+		"jeudi", "jeu",
+		"vendredi", "ven",
+		"samedi", "sam",
+		"dimanche", "dim",
+	},
+	OrdinalSuffixes: []string{"er", "ème", "eme", "e"},
+}
+
+var GermanDateNames = DateNames{
+	Months: map[string]int{
+		"januar": 1, "jänner": 1, "jan": 1,
+		"februar": 2, "feb": 2,
+		"märz": 3, "maerz": 3, "marz": 3, "mär": 3,
+		"april": 4, "apr": 4,
+		"mai":  5,
+		"juni": 6, "jun": 6,
+		"juli": 7, "jul": 7,
+		"august": 8, "aug": 8,
+		"september": 9, "sept": 9, "sep": 9,
+		"oktober": 10, "okt": 10,
+		"november": 11, "nov": 11,
+		"dezember": 12, "dez": 12,
+	},
+	Weekdays: []string{
+		"montag",
+		"dienstag",
+		"mittwoch",
+		"donnerstag",
+		"freitag",
+		"samstag", "sonnabend",
+		"sonntag",
+	},
+	OrdinalSuffixes: []string{"."},
+}
+
+var SpanishDateNames = DateNames{
+	Months: map[string]int{
+		"enero": 1, "ene": 1,
+		"febrero": 2, "feb": 2,
+		"marzo": 3, "mar": 3,
+		"abril": 4, "abr": 4,
+		"mayo": 5, "may": 5,
+		"junio": 6, "jun": 6,
+		"julio": 7, "jul": 7,
+		"agosto": 8, "ago": 8,
+		"septiembre": 9, "setiembre": 9, "sep": 9, "sept": 9,
+		"octubre": 10, "oct": 10,
+		"noviembre": 11, "nov": 11,
+		"diciembre": 12, "dic": 12,
+	},
+	Weekdays: []string{
+		"lunes", "lun",
+		"martes", "mar",
+		"miércoles", "miercoles", "mié", "mie",
+		"jueves", "jue",
+		"viernes", "vie",
+		"sábado", "sabado", "sáb", "sab",
+		"domingo", "dom",
+	},
+	OrdinalSuffixes: []string{"º", "o"},
+}
@@ -13,7 +13,7 @@ func Test_dateMatch(t *testing.T) {
 	// matches dates that use '#{sep}' as a separator"
 	for _, sep := range []string{"", " ", "-", "/", "\\", "_", "."} {
 		password := "13" + sep + "2" + sep + "1921"
-		matches := dateMatch{}.Matches(password)
+		matches := dateMatch{referenceYear: 2019}.Matches(password)
 
 		assert.Equal(t, []*match.Match{
 			{
@@ -36,7 +36,7 @@ func Test_dateMatch(t *testing.T) {
 		password := strings.Replace(order, "y", "88", 1)
 		password = strings.Replace(password, "m", "8", 1)
 		password = strings.Replace(password, "d", "8", 1)
-		matches := dateMatch{}.Matches(password)
+		matches := dateMatch{referenceYear: 2019}.Matches(password)
 
 		assert.Equal(t, []*match.Match{
 			{
@@ -68,7 +68,7 @@ func Test_dateMatch(t *testing.T) {
 			Day:       15,
 		},
 	},
-		dateMatch{}.Matches(password),
+		dateMatch{referenceYear: 2019}.Matches(password),
 	)
 
 	// matches various dates
@@ -83,7 +83,7 @@ func Test_dateMatch(t *testing.T) {
 		{22, 11, 1551},
 	} {
 		password := fmt.Sprintf("%d%d%d", tt.year, tt.month, tt.day)
-		matches := dateMatch{}.Matches(password)
+		matches := dateMatch{referenceYear: 2019}.Matches(password)
 		month, day := matches[0].Month, matches[0].Day
 		assert.Equal(t, []*match.Match{
 			{
@@ -102,7 +102,7 @@ func Test_dateMatch(t *testing.T) {
 		)
 
 		password = fmt.Sprintf("%d.%d.%d", tt.year, tt.month, tt.day)
-		matches = dateMatch{}.Matches(password)
+		matches = dateMatch{referenceYear: 2019}.Matches(password)
 		month, day = matches[0].Month, matches[0].Day
 		assert.Equal(t, []*match.Match{
 			{
@@ -136,7 +136,7 @@ func Test_dateMatch(t *testing.T) {
 			Day:       2,
 		},
 	},
-		dateMatch{}.Matches(password),
+		dateMatch{referenceYear: 2019}.Matches(password),
 	)
 
 	// matches embedded dates
@@ -152,7 +152,7 @@ func Test_dateMatch(t *testing.T) {
 				Year:      1991,
 				Month:     1,
 				Day:       1,
-			}}, dateMatch{}.Matches(pv.password))
+			}}, dateMatch{referenceYear: 2019}.Matches(pv.password))
 
 	}
 
@@ -180,7 +180,7 @@ func Test_dateMatch(t *testing.T) {
 			Day:       20,
 		},
 	},
-		dateMatch{}.Matches(password),
+		dateMatch{referenceYear: 2019}.Matches(password),
 	)
 
 	// matches dates padded by non-ambiguous digits
@@ -195,19 +195,153 @@ func Test_dateMatch(t *testing.T) {
 			Year:      1991,
 			Month:     12,
 			Day:       20,
-		}}, dateMatch{}.Matches(password))
+		}}, dateMatch{referenceYear: 2019}.Matches(password))
+}
+
+func Test_maybeDate(t *testing.T) {
+	for token, want := range map[string]bool{
+		"1191": true, "11111991": true, "119": false, "111111991": false, "11a1": false,
+	} {
+		assert.Equal(t, want, maybeDateNoSeparator(token), token)
+	}
+
+	tests := []struct {
+		token                 string
+		s1, separator, s2, s3 string
+		ok                    bool
+	}{
+		{"1/1/91", "1", "/", "1", "91", true},
+		{"11\\11\\1991", "11", "\\", "11", "1991", true},
+		{"1991 11 11", "1991", " ", "11", "11", true},
+		{"1\u00a01\u00a091", "1", "\u00a0", "1", "91", true},
+		{"1/1-91", "", "", "", "", false},
+		{"1/111/91", "", "", "", "", false},
+		{"11111/1/91", "", "", "", "", false},
+		{"1/1/91a", "", "", "", "", false},
+		{"1:1:91", "", "", "", "", false},
+	}
+	for _, tt := range tests {
+		s1, separator, s2, s3, ok := maybeDateWithSeparator(tt.token)
+		assert.Equal(t, tt.ok, ok, tt.token)
+		if tt.ok {
+			assert.Equal(t, []string{tt.s1, tt.separator, tt.s2, tt.s3}, []string{s1, separator, s2, s3}, tt.token)
+		}
+	}
 }
 
 func Test_twoToFourDigitYear(t *testing.T) {
 	tests := []struct {
-		year int
-		want int
+		year    int
+		maxYear int
+		want    int
 	}{
-		{60, 1960},
-		{960, 960},
-		{20, 2020},
+		{60, 2050, 1960},
+		{960, 2050, 960},
+		{20, 2050, 2020},
+		{50, 2050, 2050},
+		{51, 2050, 1951},
+		{51, 2057, 2051},
+		{58, 2057, 1958},
+		{0, 2099, 2000},
 	}
 	for _, tt := range tests {
-		assert.Equal(t, tt.want, twoToFourDigitYear(tt.year))
+		assert.Equal(t, tt.want, twoToFourDigitYear(tt.year, tt.maxYear))
+	}
+}
+
+func Test_dateMatchReferenceYear(t *testing.T) {
+	// the latest possible date moves with the reference year
+	for _, m := range (dateMatch{referenceYear: 2019}).Matches("25.12.2055") {
+		assert.NotEqual(t, 2055, m.Year)
+	}
+	assert.Equal(t, []*match.Match{
+		{
+			Pattern:   "date",
+			Token:     "25.12.2055",
+			I:         0,
+			J:         9,
+			Separator: ".",
+			Year:      2055,
+			Month:     12,
+			Day:       25,
+		}}, dateMatch{referenceYear: 2026}.Matches("25.12.2055"))
+
+	// and so does the century of two-digit years
+	assert.Equal(t, 1955, dateMatch{referenceYear: 2019}.Matches("25.12.55")[0].Year)
+	assert.Equal(t, 2055, dateMatch{referenceYear: 2026}.Matches("25.12.55")[0].Year)
+}
+
+func tokens(matches []*match.Match) []string {
+	var res []string
+	for _, m := range matches {
+		res = append(res, m.Token)
+	}
+	return res
+}
+
+func Test_dateMatchTextual(t *testing.T) {
+	dm := dateMatch{referenceYear: 2019, names: []DateNames{EnglishDateNames}}
+	for _, tt := range []struct {
+		password string
+		want     match.Match
+	}{
+		{"march1987", match.Match{Year: 1987, Month: 3, MonthName: "march"}},
+		{"14feb", match.Match{Month: 2, Day: 14, MonthName: "feb"}},
+		{"jan01", match.Match{Month: 1, Day: 1, MonthName: "jan"}},
+		{"Dec25", match.Match{Month: 12, Day: 25, MonthName: "Dec"}},
+		{"1987MAY", match.Match{Year: 1987, Month: 5, MonthName: "MAY"}},
+		{"feb1487", match.Match{Year: 1987, Month: 2, Day: 14, MonthName: "feb"}},
+		{"1st-march-1987", match.Match{Year: 1987, Month: 3, Day: 1, MonthName: "march", Ordinal: true, Separator: "-"}},
+		{"22nd september", match.Match{Month: 9, Day: 22, MonthName: "september", Ordinal: true, Separator: " "}},
+		{"friday13th", match.Match{Day: 13, Weekday: "friday", Ordinal: true}},
+		{"sun14feb", match.Match{Month: 2, Day: 14, MonthName: "feb", Weekday: "sun"}},
+	} {
+		want := tt.want
+		want.Pattern = "date"
+		want.Token = tt.password
+		want.I = 0
+		want.J = len(tt.password) - 1
+		assert.Equal(t, []*match.Match{&want}, dm.Matches(tt.password), "matching %s", tt.password)
+	}
+
+	// textual dates are found inside passwords
+	matches := dm.Matches("xx14feb2024!")
+	if assert.Len(t, matches, 1) {
+		assert.Equal(t, "14feb2024", matches[0].Token)
+		assert.Equal(t, 2, matches[0].I)
+	}
+
+	// month names alone, days out of range and mismatched separators are not dates
+	assert.Empty(t, dm.Matches("march"))
+	assert.Empty(t, dm.Matches("mayor"))
+	assert.Empty(t, dm.Matches("feb00"))
+	assert.Equal(t, []string{"14-feb", "feb.1987"}, tokens(dm.Matches("14-feb.1987")))
+
+	// textual dates are disabled without names
+	assert.Equal(t, []string{"1987"}, tokens(dateMatch{referenceYear: 2019}.Matches("march1987")))
+
+	// localised names
+	dm.names = []DateNames{FrenchDateNames}
+	matches = dm.Matches("14février")
+	if assert.Len(t, matches, 1) {
+		assert.Equal(t, 2, matches[0].Month)
+		assert.Equal(t, 14, matches[0].Day)
+	}
+	assert.Empty(t, dm.Matches("14feb"))
+	// weekday names
+	dm.names = []DateNames{GermanDateNames}
+	assert.Equal(t, []string{"freitag13."}, tokens(dm.Matches("freitag13.")))
+	// two-letter abbreviations would be found inside words
+	assert.Empty(t, dm.Matches("also13"))
+	dm.names = []DateNames{{Weekdays: []string{"so"}}}
+	assert.Empty(t, dm.Matches("also13"))
+	// ambiguous names are read in the same order from run to run
+	dm.names = []DateNames{{Months: map[string]int{"may": 5, "may1": 6, "mayo": 5, "maybe": 7}}}
+	for run := 0; run < 20; run++ {
+		matches := dm.Matches("may12")
+		if assert.Len(t, matches, 1) {
+			assert.Equal(t, 5, matches[0].Month)
+			assert.Equal(t, 12, matches[0].Day)
+		}
 	}
 }
@@ -0,0 +1,186 @@
+package matching
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/trustelem/zxcvbn/match"
+)
+
+// extended dates are date-like patterns that don't fit the upstream 3-tuple recipe:
+//   two-part dates: "2024-05", "05/2024", "05/24" (month and day, or month and year),
+//   times of day: "12:30", "12h30", "1230", "23:59:59",
+//   ISO 8601 week dates: "2024W18", "2024-W18-3",
+//   ISO 8601 date-times: "20240501T1200", "2024-05-01T12:00:00Z".
+//
+// as for other dates, this isn't true date parsing: "02/31" is a date.
+
+var (
+	isoBasicDateTime    = regexp.MustCompile(`^(\d{4})(\d{2})(\d{2})[Tt](\d{2})(\d{2})(\d{2})?Z?`)
+	isoExtendedDateTime = regexp.MustCompile(`^(\d{4})-(\d{2})-(\d{2})[Tt ](\d{2}):(\d{2})(?::(\d{2}))?Z?`)
+	isoBasicWeek        = regexp.MustCompile(`^(\d{4})[Ww](\d{2})([1-7])?`)
+	isoExtendedWeek     = regexp.MustCompile(`^(\d{4})-[Ww](\d{2})(?:-([1-7]))?`)
+	maybeTime           = regexp.MustCompile(`^(\d{2})(\d{2})|^(\d{1,2})([:h.])(\d{2})(?::(\d{2}))?`)
+)
+
+// twoPartDateSeparators are the separators of dates, as in maybeDateWithSeparator.
+const twoPartDateSeparators = " \t\n\f\r\v/\\_.-"
+
+func (dm dateMatch) extendedMatches(password string) []*match.Match {
+	var matches []*match.Match
+	for i := 0; i < len(password); i++ {
+		if !isDigit(password[i]) {
+			continue
+		}
+		for _, m := range []*match.Match{
+			dm.isoDateTimeAt(password, i, isoExtendedDateTime, "-"),
+			dm.isoDateTimeAt(password, i, isoBasicDateTime, ""),
+			dm.isoWeekAt(password, i, isoExtendedWeek, "-"),
+			dm.isoWeekAt(password, i, isoBasicWeek, ""),
+			timeAt(password, i),
+		} {
+			if m != nil {
+				matches = append(matches, m)
+			}
+		}
+		matches = append(matches, dm.twoPartDatesAt(password, i)...)
+	}
+	return matches
+}
+
+func (dm dateMatch) isoDateTimeAt(password string, i int, re *regexp.Regexp, separator string) *match.Match {
+	g := re.FindStringSubmatch(password[i:])
+	if g == nil {
+		return nil
+	}
+	year, month, day := atoi(g[1]), atoi(g[2]), atoi(g[3])
+	if year < dateMinYear || year > dm.maxYear() || month < 1 || month > 12 || day < 1 || day > 31 {
+		return nil
+	}
+	timeOfDay := formatTimeOfDay(g[4], g[5], g[6])
+	if timeOfDay == "" {
+		return nil
+	}
+	return &match.Match{
+		Pattern:   "date",
+		Token:     g[0],
+		I:         i,
+		J:         i + len(g[0]) - 1,
+		Separator: separator,
+		Year:      year,
+		Month:     month,
+		Day:       day,
+		TimeOfDay: timeOfDay,
+	}
+}
+
+func (dm dateMatch) isoWeekAt(password string, i int, re *regexp.Regexp, separator string) *match.Match {
+	g := re.FindStringSubmatch(password[i:])
+	if g == nil {
+		return nil
+	}
+	year, week := atoi(g[1]), atoi(g[2])
+	if year < dateMinYear || year > dm.maxYear() || week < 1 || week > 53 {
+		return nil
+	}
+	return &match.Match{
+		Pattern:   "date",
+		Token:     g[0],
+		I:         i,
+		J:         i + len(g[0]) - 1,
+		Separator: separator,
+		Year:      year,
+		Week:      week,
+		Day:       atoi(g[3]),
+	}
+}
+
+func timeAt(password string, i int) *match.Match {
+	g := maybeTime.FindStringSubmatch(password[i:])
+	if g == nil {
+		return nil
+	}
+	hours, minutes := g[1], g[2]
+	if hours == "" {
+		hours, minutes = g[3], g[5]
+	}
+	timeOfDay := formatTimeOfDay(hours, minutes, g[6])
+	if timeOfDay == "" {
+		return nil
+	}
+	return &match.Match{
+		Pattern:   "date",
+		Token:     g[0],
+		I:         i,
+		J:         i + len(g[0]) - 1,
+		Separator: g[4],
+		TimeOfDay: timeOfDay,
+	}
+}
+
+// twoPartDatesAt returns the dates made of two numbers starting at password[i]:
+// a year and a month, or a month and a day, or a month and a 2-digit year.
+func (dm dateMatch) twoPartDatesAt(password string, i int) []*match.Match {
+	var matches []*match.Match
+	for l1 := 1; l1 <= 4 && i+l1 < len(password) && isDigit(password[i+l1-1]); l1++ {
+		sepIndex := i + l1
+		if strings.IndexByte(twoPartDateSeparators, password[sepIndex]) < 0 {
+			continue
+		}
+		for l2 := 1; l2 <= 4 && sepIndex+l2 < len(password) && isDigit(password[sepIndex+l2]); l2++ {
+			token := password[i : sepIndex+l2+1]
+			a, b := atoi(password[i:sepIndex]), atoi(password[sepIndex+1:sepIndex+l2+1])
+			m := &match.Match{
+				Pattern:   "date",
+				Token:     token,
+				I:         i,
+				J:         sepIndex + l2,
+				Separator: password[sepIndex : sepIndex+1],
+			}
+			switch {
+			case l1 == 4 && l2 <= 2:
+				m.Year, m.Month = a, b
+			case l1 <= 2 && l2 == 4:
+				m.Month, m.Year = a, b
+			case l1 <= 2 && l2 <= 2:
+				if dmy := mapIntsToDM(a, b, 0); dmy != nil && dmy.Day >= 1 && dmy.Month >= 1 {
+					m.Day, m.Month = dmy.Day, dmy.Month
+				} else if l2 == 2 {
+					m.Month, m.Year = a, twoToFourDigitYear(b, dm.maxYear())
+				}
+			}
+			if m.Month < 1 || m.Month > 12 {
+				continue
+			}
+			if m.Year != 0 && (m.Year < dateMinYear || m.Year > dm.maxYear()) {
+				continue
+			}
+			matches = append(matches, m)
+		}
+	}
+	return matches
+}
+
+// formatTimeOfDay returns hh:mm or hh:mm:ss, or "" if the time isn't valid.
+// seconds may be empty.
+func formatTimeOfDay(hours, minutes, seconds string) string {
+	h, m := atoi(hours), atoi(minutes)
+	if h > 23 || m > 59 {
+		return ""
+	}
+	if seconds == "" {
+		return fmt.Sprintf("%02d:%02d", h, m)
+	}
+	s := atoi(seconds)
+	if s > 59 {
+		return ""
+	}
+	return fmt.Sprintf("%02d:%02d:%02d", h, m, s)
+}
+
+func atoi(s string) int {
+	i, _ := strconv.Atoi(s)
+	return i
+}
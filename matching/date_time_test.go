@@ -0,0 +1,58 @@
+package matching
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustelem/zxcvbn/match"
+)
+
+func Test_dateMatchExtended(t *testing.T) {
+	dm := dateMatch{referenceYear: 2024, extended: true}
+	for _, tt := range []struct {
+		password string
+		want     match.Match
+	}{
+		// two-part dates
+		{"2024-05", match.Match{Year: 2024, Month: 5, Separator: "-"}},
+		{"05/2024", match.Match{Year: 2024, Month: 5, Separator: "/"}},
+		{"05/24", match.Match{Month: 5, Day: 24, Separator: "/"}},
+		{"24.12", match.Match{Month: 12, Day: 24, Separator: "."}},
+		{"12/99", match.Match{Month: 12, Year: 1999, Separator: "/"}},
+		// times of day
+		{"12:30", match.Match{TimeOfDay: "12:30", Separator: ":"}},
+		{"7h45", match.Match{TimeOfDay: "07:45", Separator: "h"}},
+		{"23:59:59", match.Match{TimeOfDay: "23:59:59", Separator: ":"}},
+		// ISO 8601 week dates
+		{"2024W18", match.Match{Year: 2024, Week: 18}},
+		{"2024-W18", match.Match{Year: 2024, Week: 18, Separator: "-"}},
+		{"2024-W18-3", match.Match{Year: 2024, Week: 18, Day: 3, Separator: "-"}},
+		// ISO 8601 date-times
+		{"20240501T1200", match.Match{Year: 2024, Month: 5, Day: 1, TimeOfDay: "12:00"}},
+		{"2024-05-01T12:00:30Z", match.Match{Year: 2024, Month: 5, Day: 1, TimeOfDay: "12:00:30", Separator: "-"}},
+	} {
+		want := tt.want
+		want.Pattern = "date"
+		want.Token = tt.password
+		want.I = 0
+		want.J = len(tt.password) - 1
+		assert.Equal(t, []*match.Match{&want}, dm.Matches(tt.password), "matching %s", tt.password)
+	}
+
+	// "1230" is both a time and a date: both readings are kept
+	matches := dm.Matches("1230")
+	if assert.Len(t, matches, 2) {
+		assert.Equal(t, "12:30", matches[1].TimeOfDay)
+		assert.Equal(t, 2030, matches[0].Year)
+	}
+
+	// invalid months, weeks and times
+	for _, password := range []string{"2024-13", "2024W54", "24:00", "12:60", "20241301T1200"} {
+		for _, m := range dm.Matches(password) {
+			assert.NotEqual(t, password, m.Token, "matching %s", password)
+		}
+	}
+
+	// extended dates are disabled by default
+	assert.Empty(t, dateMatch{referenceYear: 2024}.Matches("12:30"))
+}
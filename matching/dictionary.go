@@ -1,6 +1,7 @@
 package matching
 
 import (
+	"sort"
 	"strings"
 
 	"github.com/trustelem/zxcvbn/match"
@@ -8,6 +9,10 @@ import (
 
 type dictionaryMatch struct {
 	rankedDictionaries map[string]rankedDictionnary
+	// words indexes the words of all rankedDictionaries, if not nil.
+	words []sortedWords
+	// extra are the dictionaries of Options.Dictionaries, with their word indexes.
+	extra map[string]*RankedDictionary
 }
 
 func (dm dictionaryMatch) Matches(password string) []*match.Match {
@@ -43,16 +48,79 @@ func (dm dictionaryMatch) Matches(password string) []*match.Match {
 }
 
 func (dm dictionaryMatch) withDict(name string, d rankedDictionnary) dictionaryMatch {
+	return dm.withSortedDict(name, d, newSortedWords(d))
+}
+
+// withSortedDict adds the dictionary d, whose words are already sorted.
+func (dm dictionaryMatch) withSortedDict(name string, d rankedDictionnary, words sortedWords) dictionaryMatch {
 	rd2 := make(map[string]rankedDictionnary, len(dm.rankedDictionaries)+1)
 	for k, v := range dm.rankedDictionaries {
 		rd2[k] = v
 	}
 	rd2[name] = d
-	return dictionaryMatch{rankedDictionaries: rd2}
+	dm2 := dictionaryMatch{rankedDictionaries: rd2, extra: dm.extra}
+	if dm.words != nil {
+		dm2.words = append(dm.words[:len(dm.words):len(dm.words)], words)
+	}
+	return dm2
+}
+
+// withRankedDictionary adds d, with the word indexes it was built with.
+func (dm dictionaryMatch) withRankedDictionary(name string, d *RankedDictionary) dictionaryMatch {
+	dm2 := dm.withSortedDict(name, d.ranked, d.words)
+	dm2.extra = make(map[string]*RankedDictionary, len(dm.extra)+1)
+	for k, v := range dm.extra {
+		dm2.extra[k] = v
+	}
+	dm2.extra[name] = d
+	return dm2
+}
+
+// prefixIndex returns the words of the dictionaries, indexing them if needed.
+func (dm dictionaryMatch) prefixIndex() []sortedWords {
+	if dm.words != nil {
+		return dm.words
+	}
+	dicts := make([]rankedDictionnary, 0, len(dm.rankedDictionaries))
+	for _, d := range dm.rankedDictionaries {
+		dicts = append(dicts, d)
+	}
+	return []sortedWords{newSortedWords(dicts...)}
+}
+
+// dictionaryMatchKey identifies a dictionary match, to find matchers' duplicates.
+type dictionaryMatchKey struct {
+	i, j           int
+	dictionaryName string
+	word           string
 }
 
 type rankedDictionnary map[string]int
 
+// RankedDictionary is a frequency list matched along with the default ones, such as the
+// common words of another language. See Options.Dictionaries.
+type RankedDictionary struct {
+	ranked rankedDictionnary
+	words  sortedWords
+	// skeletons and t9 index the words for the abbreviation and T9 matchers.
+	skeletons wordIndex
+	t9        wordIndex
+}
+
+// NewRankedDictionary returns the dictionary of words, ordered from the most frequent
+// to the least. It indexes the words for every matcher, so it is meant to be built
+// once and shared by the estimations.
+func NewRankedDictionary(words []string) *RankedDictionary {
+	d := buildRankedDict(words)
+	dicts := map[string]rankedDictionnary{"": d}
+	return &RankedDictionary{
+		ranked:    d,
+		words:     newSortedWords(d),
+		skeletons: newWordIndex(dicts, skeletonCode),
+		t9:        newWordIndex(dicts, t9Code),
+	}
+}
+
 func buildRankedDict(unrankedList []string) rankedDictionnary {
 	result := make(rankedDictionnary)
 
@@ -62,3 +130,28 @@ func buildRankedDict(unrankedList []string) rankedDictionnary {
 
 	return result
 }
+
+// sortedWords are the words of some dictionaries, sorted to find the words starting
+// with a prefix.
+type sortedWords []string
+
+func newSortedWords(dicts ...rankedDictionnary) sortedWords {
+	seen := make(map[string]bool)
+	var words sortedWords
+	for _, d := range dicts {
+		for w := range d {
+			if !seen[w] {
+				seen[w] = true
+				words = append(words, w)
+			}
+		}
+	}
+	sort.Strings(words)
+	return words
+}
+
+// hasPrefix reports whether a word starts with prefix.
+func (w sortedWords) hasPrefix(prefix string) bool {
+	i := sort.SearchStrings(w, prefix)
+	return i < len(w) && strings.HasPrefix(w[i], prefix)
+}
@@ -0,0 +1,48 @@
+package matching
+
+import (
+	"strings"
+
+	"github.com/trustelem/zxcvbn/internal/unicodeutils"
+	"github.com/trustelem/zxcvbn/match"
+)
+
+// foldedDictionaryMatch matches dictionary words in the password folded with
+// unicodeutils.Fold: "pässwörd", "passwоrd" (with a Cyrillic о) or "ｐａｓｓｗｏｒｄ"
+// are all matched as "password".
+type foldedDictionaryMatch struct {
+	dm dictionaryMatch
+}
+
+func (fm foldedDictionaryMatch) Matches(password string) []*match.Match {
+	folded, offsets := unicodeutils.Fold(password)
+	if offsets == nil {
+		// nothing to fold: plain dictionary matches are enough
+		return nil
+	}
+	var matches []*match.Match
+	for _, m := range fm.dm.Matches(folded) {
+		// the bytes a character expands to ("ss" for "ß") all map to its offset: matches
+		// starting or ending inside an expansion don't match whole characters
+		if (m.I > 0 && offsets[m.I] == offsets[m.I-1]) || offsets[m.J+1] == offsets[m.J] {
+			continue
+		}
+		i, j := offsets[m.I], offsets[m.J+1]-1
+		token := password[i : j+1]
+		if strings.ToLower(token) == m.MatchedWord {
+			continue // only return the matches that were actually folded
+		}
+		m.Folds = make(map[string]string)
+		for _, r := range strings.ToLower(token) {
+			if f := strings.ToLower(unicodeutils.FoldRune(r)); f != string(r) {
+				m.Folds[string(r)] = f
+			}
+		}
+		m.I, m.J = i, j
+		m.Token = token
+		m.Folded = true
+		matches = append(matches, m)
+	}
+	match.Sort(matches)
+	return matches
+}
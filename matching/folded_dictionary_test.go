@@ -0,0 +1,74 @@
+package matching
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustelem/zxcvbn/match"
+)
+
+func TestFoldedDictionaryMatching(t *testing.T) {
+	fm := foldedDictionaryMatch{dm: dictionaryMatch{
+		rankedDictionaries: map[string]rankedDictionnary{
+			"d1": {
+				"password": 2,
+				"word":     7,
+				"touché":   9,
+			},
+		},
+	}}
+
+	for _, tt := range []struct {
+		password string
+		folds    map[string]string
+	}{
+		{"pässwörd", map[string]string{"ä": "a", "ö": "o"}},
+		{"passwоrd", map[string]string{"о": "o"}}, // Cyrillic о
+		{"ｐａｓｓｗｏｒｄ", map[string]string{"ｐ": "p", "ａ": "a", "ｓ": "s", "ｗ": "w", "ｏ": "o", "ｒ": "r", "ｄ": "d"}},
+		{"PASSWÖRD", map[string]string{"ö": "o"}},
+		{"pässword", map[string]string{"̈": ""}},
+	} {
+		matches := fm.Matches(tt.password)
+		if !assert.NotEmpty(t, matches, tt.password) {
+			continue
+		}
+		// the whole password is matched, with its original offsets and token
+		m := matches[0]
+		assert.Equal(t, tt.password, m.Token, tt.password)
+		assert.Equal(t, 0, m.I, tt.password)
+		assert.Equal(t, len(tt.password)-1, m.J, tt.password)
+		assert.Equal(t, "password", m.MatchedWord, tt.password)
+		assert.Equal(t, 2, m.Rank, tt.password)
+		assert.True(t, m.Folded, tt.password)
+		assert.Equal(t, tt.folds, m.Folds, tt.password)
+	}
+
+	// words that don't need folding are left to the dictionary matcher
+	assert.Equal(t, []*match.Match{
+		{
+			Pattern:        "dictionary",
+			Token:          "wörd",
+			I:              9,
+			J:              13,
+			MatchedWord:    "word",
+			Rank:           7,
+			DictionaryName: "d1",
+			Folded:         true,
+			Folds:          map[string]string{"ö": "o"},
+		},
+	}, fm.Matches("password wörd"))
+	assert.Empty(t, fm.Matches("password"))
+	assert.Empty(t, fm.Matches("touché"))
+
+	// matches start and end on whole characters of the password
+	fm.dm.rankedDictionaries["d1"] = buildRankedDict([]string{"as", "ether", "strasse", "aether", "se"})
+	tokens := func(password string) []string {
+		var tokens []string
+		for _, m := range fm.Matches(password) {
+			tokens = append(tokens, m.Token+":"+m.MatchedWord)
+		}
+		return tokens
+	}
+	assert.Equal(t, []string{"straße:strasse"}, tokens("straße"))
+	assert.Equal(t, []string{"æther:aether"}, tokens("æther"))
+}
@@ -0,0 +1,241 @@
+package matching
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/trustelem/zxcvbn/internal/mathutils"
+	"github.com/trustelem/zxcvbn/match"
+)
+
+// fuzzyDictionaryMatch matches dictionary words with typos: tokens within
+// Damerau-Levenshtein distance maxDistance of a word, such as "pasword", "passwrod"
+// or "iloveyuo". Distances count runes, so "passwörd" is one typo away from "password".
+//
+// from each position of the password, the dictionary words are walked as a trie,
+// computing the distance of each prefix to the password, and the walk leaves the
+// prefixes farther than maxDistance from every token.
+type fuzzyDictionaryMatch struct {
+	dm          dictionaryMatch
+	maxDistance int
+}
+
+const (
+	// maxEditDistance bounds Options.MaxEditDistance.
+	maxEditDistance = 2
+	// minWordLengthPerEdit is the length of the shortest words matched with typos,
+	// per edit: 4 letters with 1 typo, 8 letters with 2.
+	minWordLengthPerEdit = 4
+	// maxFuzzyTokenLength bounds the length in runes of the tokens matched with typos.
+	maxFuzzyTokenLength = 32
+)
+
+func (fm fuzzyDictionaryMatch) Matches(password string) []*match.Match {
+	w := &fuzzyWalk{
+		fm:            fm,
+		password:      password,
+		lowerPassword: strings.ToLower(password),
+		found:         make(map[dictionaryMatchKey]bool),
+	}
+	for i := range password {
+		w.from(i)
+	}
+
+	sort.SliceStable(w.matches, func(a, b int) bool {
+		ma, mb := w.matches[a], w.matches[b]
+		if ma.DictionaryName != mb.DictionaryName {
+			return ma.DictionaryName < mb.DictionaryName
+		}
+		return ma.MatchedWord < mb.MatchedWord
+	})
+	match.Sort(w.matches)
+	return w.matches
+}
+
+type fuzzyWalk struct {
+	fm            fuzzyDictionaryMatch
+	password      string
+	lowerPassword string
+
+	// the walk from password[i:]: token is the lower-cased password from i, one rune per
+	// character, offsets[k] is the length in bytes of token[:k], and rows[d][k] is the
+	// distance between the prefix of d runes and token[:k].
+	i       int
+	token   []rune
+	offsets []int
+	prefix  []rune
+	rows    [][]int
+	// buffers[d] is the row of length d, reused from one prefix to the next.
+	buffers [][]int
+
+	matches []*match.Match
+	found   map[dictionaryMatchKey]bool
+}
+
+func (w *fuzzyWalk) from(i int) {
+	w.i = i
+	w.token = w.token[:0]
+	w.offsets = append(w.offsets[:0], 0)
+	for k, c := range w.password[i:] {
+		if len(w.token) == maxFuzzyTokenLength {
+			break
+		}
+		w.token = append(w.token, unicode.ToLower(c))
+		w.offsets = append(w.offsets, k+utf8.RuneLen(c))
+	}
+	w.buffers = w.buffers[:0]
+	row := make([]int, len(w.token)+1)
+	for k := range row {
+		row[k] = mathutils.Min(k, w.fm.maxDistance+1)
+	}
+	w.buffers = append(w.buffers, row)
+	w.rows = append(w.rows[:0], row)
+	w.prefix = w.prefix[:0]
+	for _, words := range w.fm.dm.prefixIndex() {
+		if len(words) > 0 {
+			w.walk(words, 0, len(words), 0)
+		}
+	}
+}
+
+// walk visits the words of words[lo:hi], which share their first depth bytes.
+func (w *fuzzyWalk) walk(words sortedWords, lo, hi, depth int) {
+	if len(words[lo]) == depth {
+		w.emit(words[lo])
+		lo++
+	}
+	for lo < hi {
+		c, size := utf8.DecodeRuneInString(words[lo][depth:])
+		prefix := words[lo][:depth+size]
+		from := lo
+		end := lo + sort.Search(hi-lo, func(x int) bool { return !strings.HasPrefix(words[from+x], prefix) })
+		if w.push(c) {
+			w.walk(words, lo, end, depth+size)
+		}
+		w.rows = w.rows[:len(w.prefix)]
+		w.prefix = w.prefix[:len(w.prefix)-1]
+		lo = end
+	}
+}
+
+// push adds the row of the prefix extended with c, and reports whether it is close
+// enough to a token to be worth extending.
+func (w *fuzzyWalk) push(c rune) bool {
+	w.prefix = append(w.prefix, c)
+	d := len(w.prefix)
+	prev := w.rows[d-1]
+	// distances are only computed up to maxDistance+1: token[:k] is farther than
+	// maxDistance from prefix when k and d are farther apart.
+	far := w.fm.maxDistance + 1
+	var row []int
+	if d < len(w.buffers) {
+		row = w.buffers[d]
+	} else {
+		row = make([]int, len(prev))
+		for k := range row {
+			row[k] = far
+		}
+		w.buffers = append(w.buffers, row)
+	}
+	row[0] = mathutils.Min(d, far)
+	closest := row[0]
+	for k := mathutils.Max(1, d-far+1); k < len(row) && k < d+far; k++ {
+		cost := 1
+		if w.token[k-1] == c {
+			cost = 0
+		}
+		row[k] = mathutils.Min(mathutils.Min(prev[k]+1, row[k-1]+1), prev[k-1]+cost)
+		if d >= 2 && k >= 2 && c == w.token[k-2] && w.prefix[d-2] == w.token[k-1] {
+			row[k] = mathutils.Min(row[k], w.rows[d-2][k-2]+1)
+		}
+		row[k] = mathutils.Min(row[k], far)
+		if row[k] < closest {
+			closest = row[k]
+		}
+	}
+	w.rows = append(w.rows, row)
+	return closest < far
+}
+
+// emit adds the matches of word, the current prefix, with the longest of the tokens
+// closest to it.
+func (w *fuzzyWalk) emit(word string) {
+	row := w.rows[len(w.prefix)]
+	distance, k := -1, 0
+	for end := 1; end < len(row); end++ {
+		if distance < 0 || row[end] <= distance {
+			distance, k = row[end], end
+		}
+	}
+	if distance <= 0 || distance > w.fm.maxDistance || len(w.prefix) < minWordLengthPerEdit*distance {
+		return
+	}
+	if strings.Contains(w.lowerPassword, word) {
+		return // typos of words that are also typed right are left to the dictionary matcher
+	}
+	token := w.password[w.i : w.i+w.offsets[k]]
+	lower := strings.ToLower(token)
+	for dictionaryName, rankedDict := range w.fm.dm.rankedDictionaries {
+		rank, ok := rankedDict[word]
+		if !ok {
+			continue
+		}
+		if _, exact := rankedDict[lower]; exact {
+			continue // the token is a word too
+		}
+		key := dictionaryMatchKey{i: w.i, j: w.i + len(token) - 1, dictionaryName: dictionaryName, word: word}
+		if w.found[key] {
+			continue
+		}
+		w.found[key] = true
+		w.matches = append(w.matches, &match.Match{
+			Pattern:        "dictionary",
+			I:              w.i,
+			J:              w.i + len(token) - 1,
+			Token:          token,
+			MatchedWord:    word,
+			Rank:           rank,
+			DictionaryName: dictionaryName,
+			Edits:          w.edits(k),
+		})
+	}
+}
+
+// edits returns the edits turning the current prefix into token[:k], going back through
+// the rows. Positions are byte offsets in the token.
+func (w *fuzzyWalk) edits(k int) []match.Edit {
+	var edits []match.Edit
+	word := w.prefix
+	// at returns the characters of the token from rune a to rune b, as typed
+	at := func(a, b int) string {
+		return w.password[w.i+w.offsets[a] : w.i+w.offsets[b]]
+	}
+	d := len(word)
+	for d > 0 || k > 0 {
+		current := w.rows[d][k]
+		switch {
+		case d > 0 && k > 0 && word[d-1] == w.token[k-1] && current == w.rows[d-1][k-1]:
+			d, k = d-1, k-1
+			continue
+		case d > 1 && k > 1 && word[d-1] == w.token[k-2] && word[d-2] == w.token[k-1] && current == w.rows[d-2][k-2]+1:
+			edits = append(edits, match.Edit{Operation: "transposition", Position: w.offsets[k-2], From: string(word[d-2 : d]), To: at(k-2, k)})
+			d, k = d-2, k-2
+		case d > 0 && k > 0 && current == w.rows[d-1][k-1]+1:
+			edits = append(edits, match.Edit{Operation: "substitution", Position: w.offsets[k-1], From: string(word[d-1 : d]), To: at(k-1, k)})
+			d, k = d-1, k-1
+		case d > 0 && current == w.rows[d-1][k]+1:
+			edits = append(edits, match.Edit{Operation: "deletion", Position: w.offsets[k], From: string(word[d-1 : d])})
+			d--
+		default:
+			edits = append(edits, match.Edit{Operation: "insertion", Position: w.offsets[k-1], To: at(k-1, k)})
+			k--
+		}
+	}
+	// edits were found from the end of the token
+	for a, b := 0, len(edits)-1; a < b; a, b = a+1, b-1 {
+		edits[a], edits[b] = edits[b], edits[a]
+	}
+	return edits
+}
@@ -0,0 +1,137 @@
+package matching
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustelem/zxcvbn/match"
+)
+
+func TestFuzzyDictionaryMatching(t *testing.T) {
+	dm := dictionaryMatch{
+		rankedDictionaries: map[string]rankedDictionnary{
+			"d1": buildRankedDict([]string{"password", "iloveyou", "monkey", "cat"}),
+			"d2": buildRankedDict([]string{"dragonfly", "monkey"}),
+		},
+	}
+	fm := fuzzyDictionaryMatch{dm: dm, maxDistance: 1}
+
+	for _, tt := range []struct {
+		password string
+		want     []*match.Match
+	}{
+		{
+			password: "pasword",
+			want: []*match.Match{{
+				Pattern: "dictionary", I: 0, J: 6, Token: "pasword",
+				MatchedWord: "password", Rank: 1, DictionaryName: "d1",
+				Edits: []match.Edit{{Operation: "deletion", Position: 2, From: "s"}},
+			}},
+		},
+		{
+			password: "xxPASSWROD",
+			want: []*match.Match{{
+				Pattern: "dictionary", I: 2, J: 9, Token: "PASSWROD",
+				MatchedWord: "password", Rank: 1, DictionaryName: "d1",
+				Edits: []match.Edit{{Operation: "transposition", Position: 5, From: "or", To: "RO"}},
+			}},
+		},
+		{
+			password: "iloveyuo",
+			want: []*match.Match{{
+				Pattern: "dictionary", I: 0, J: 7, Token: "iloveyuo",
+				MatchedWord: "iloveyou", Rank: 2, DictionaryName: "d1",
+				Edits: []match.Edit{{Operation: "transposition", Position: 6, From: "ou", To: "uo"}},
+			}},
+		},
+		{
+			password: "passwird",
+			want: []*match.Match{{
+				Pattern: "dictionary", I: 0, J: 7, Token: "passwird",
+				MatchedWord: "password", Rank: 1, DictionaryName: "d1",
+				Edits: []match.Edit{{Operation: "substitution", Position: 5, From: "o", To: "i"}},
+			}},
+		},
+		{
+			password: "monkeey",
+			want: []*match.Match{
+				{
+					Pattern: "dictionary", I: 0, J: 6, Token: "monkeey",
+					MatchedWord: "monkey", Rank: 3, DictionaryName: "d1",
+					Edits: []match.Edit{{Operation: "insertion", Position: 4, To: "e"}},
+				},
+				{
+					Pattern: "dictionary", I: 0, J: 6, Token: "monkeey",
+					MatchedWord: "monkey", Rank: 2, DictionaryName: "d2",
+					Edits: []match.Edit{{Operation: "insertion", Position: 4, To: "e"}},
+				},
+			},
+		},
+		// a non-ASCII typo is one edit, and positions are byte offsets in the token
+		{
+			password: "xPASSWÖRD",
+			want: []*match.Match{{
+				Pattern: "dictionary", I: 1, J: 9, Token: "PASSWÖRD",
+				MatchedWord: "password", Rank: 1, DictionaryName: "d1",
+				Edits: []match.Edit{{Operation: "substitution", Position: 5, From: "o", To: "Ö"}},
+			}},
+		},
+		// words are only matched with typos if no token matches them exactly
+		{password: "passwordd", want: nil},
+		// short words aren't matched with typos
+		{password: "cta", want: nil},
+		{password: "dragonfli", want: []*match.Match{{
+			Pattern: "dictionary", I: 0, J: 8, Token: "dragonfli",
+			MatchedWord: "dragonfly", Rank: 1, DictionaryName: "d2",
+			Edits: []match.Edit{{Operation: "substitution", Position: 8, From: "y", To: "i"}},
+		}}},
+		// 2 typos need maxDistance 2
+		{password: "drgonfli", want: nil},
+	} {
+		assert.Equal(t, tt.want, fm.Matches(tt.password), tt.password)
+	}
+
+	fm.maxDistance = 2
+	assert.Equal(t, []*match.Match{{
+		Pattern: "dictionary", I: 0, J: 7, Token: "drgonfli",
+		MatchedWord: "dragonfly", Rank: 1, DictionaryName: "d2",
+		Edits: []match.Edit{
+			{Operation: "deletion", Position: 2, From: "a"},
+			{Operation: "substitution", Position: 7, From: "y", To: "i"},
+		},
+	}}, fm.Matches("drgonfli"))
+	// 2 typos need 8 letters
+	for _, m := range fm.Matches("mnkee") {
+		assert.NotEqual(t, "monkey", m.MatchedWord)
+	}
+}
+
+func TestFuzzyDictionaryMatchingOmnimatch(t *testing.T) {
+	fuzzy := func(opts Options, password string) []string {
+		var words []string
+		for _, m := range Omnimatch(password, nil, opts) {
+			if len(m.Edits) > 0 {
+				words = append(words, m.MatchedWord)
+			}
+		}
+		return words
+	}
+	assert.Empty(t, fuzzy(testOptions, "passwrod"))
+	opts := testOptions
+	opts.MaxEditDistance = 1
+	assert.Contains(t, fuzzy(opts, "passwrod"), "password")
+	assert.Contains(t, fuzzy(opts, "iloveyuo"), "iloveyou")
+}
+
+func BenchmarkFuzzyDictionaryMatch(b *testing.B) {
+	for _, maxDistance := range []int{1, 2} {
+		fm := fuzzyDictionaryMatch{dm: defaultRankedDictionnaries, maxDistance: maxDistance}
+		for _, password := range []string{"passwrod", "coRrecth0rseba++ery9.23.2007staple$"} {
+			b.Run(password, func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					fm.Matches(password)
+				}
+			})
+		}
+	}
+}
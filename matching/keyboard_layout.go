@@ -0,0 +1,91 @@
+package matching
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/trustelem/zxcvbn/match"
+)
+
+// keyboardLayoutMatch matches dictionary words typed with the keyboard in the wrong
+// layout: the password is mapped through each pair of layouts, both ways, and the
+// dictionary words of the result are matched. "ghbdtn" is "привет" typed in QWERTY,
+// "зфыыцщкв" is "password" typed in ЙЦУКЕН.
+type keyboardLayoutMatch struct {
+	dm    dictionaryMatch
+	pairs []*KeyboardLayoutPair
+}
+
+func (km keyboardLayoutMatch) Matches(password string) []*match.Match {
+	var matches []*match.Match
+	for _, p := range km.pairs {
+		matches = append(matches, km.matchesFrom(password, p.from, p.to, p.forward)...)
+		matches = append(matches, km.matchesFrom(password, p.to, p.from, p.backward)...)
+	}
+	sort.SliceStable(matches, func(a, b int) bool {
+		ma, mb := matches[a], matches[b]
+		if ma.SourceLayout != mb.SourceLayout {
+			return ma.SourceLayout < mb.SourceLayout
+		}
+		if ma.TargetLayout != mb.TargetLayout {
+			return ma.TargetLayout < mb.TargetLayout
+		}
+		if ma.DictionaryName != mb.DictionaryName {
+			return ma.DictionaryName < mb.DictionaryName
+		}
+		return ma.MatchedWord < mb.MatchedWord
+	})
+	match.Sort(matches)
+	return matches
+}
+
+// matchesFrom returns the matches of password typed in layout from, mapped to layout to.
+func (km keyboardLayoutMatch) matchesFrom(password, from, to string, keys map[rune]rune) []*match.Match {
+	mapped, offsets := mapKeys(password, keys)
+	if offsets == nil {
+		return nil
+	}
+	var matches []*match.Match
+	for _, m := range km.dm.Matches(mapped) {
+		i, j := offsets[m.I], offsets[m.J+1]-1
+		token := password[i : j+1]
+		if strings.ToLower(token) == m.MatchedWord {
+			continue // left to the dictionary matcher
+		}
+		m.Pattern = "keyboard_layout"
+		m.I, m.J = i, j
+		m.Token = token
+		m.SourceLayout = from
+		m.TargetLayout = to
+		m.LayoutPairs = len(km.pairs)
+		matches = append(matches, m)
+	}
+	return matches
+}
+
+// mapKeys replaces the characters of s that are keys, lowercased, by what they map to.
+// offsets maps the byte indexes of the result, and its length, to byte indexes of s.
+// It is nil when no character of s is a key.
+func mapKeys(s string, keys map[rune]rune) (mapped string, offsets []int) {
+	var b strings.Builder
+	changed := false
+	offsets = make([]int, 0, len(s)+1)
+	for i, r := range s {
+		m, ok := keys[unicode.ToLower(r)]
+		if ok {
+			changed = true
+		} else {
+			m = r
+		}
+		n, _ := b.WriteRune(m)
+		for k := 0; k < n; k++ {
+			offsets = append(offsets, i)
+		}
+	}
+	if !changed {
+		return s, nil
+	}
+	offsets = append(offsets, len(s))
+	return b.String(), offsets
+}
@@ -0,0 +1,90 @@
+package matching
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustelem/zxcvbn/match"
+)
+
+func Test_mapKeys(t *testing.T) {
+	mapped, offsets := mapKeys("Ghb1", QwertyRussianLayouts.forward)
+	assert.Equal(t, "при1", mapped)
+	assert.Equal(t, []int{0, 0, 1, 1, 2, 2, 3, 4}, offsets)
+
+	// nothing to map
+	mapped, offsets = mapKeys("123", QwertyRussianLayouts.forward)
+	assert.Equal(t, "123", mapped)
+	assert.Nil(t, offsets)
+}
+
+func TestKeyboardLayoutMatching(t *testing.T) {
+	dm := dictionaryMatch{
+		rankedDictionaries: map[string]rankedDictionnary{
+			"d1": buildRankedDict([]string{"password", "123"}),
+			"d2": buildRankedDict([]string{"мир", "привет"}),
+		},
+	}
+	km := keyboardLayoutMatch{dm: dm, pairs: []*KeyboardLayoutPair{QwertyRussianLayouts, QwertyGreekLayouts}}
+
+	for _, tt := range []struct {
+		password string
+		want     []*match.Match
+	}{
+		{
+			password: "Ghbdtn123",
+			want: []*match.Match{{
+				Pattern: "keyboard_layout", I: 0, J: 5, Token: "Ghbdtn",
+				MatchedWord: "привет", Rank: 2, DictionaryName: "d2",
+				SourceLayout: "qwerty", TargetLayout: "russian", LayoutPairs: 2,
+			}},
+		},
+		{
+			password: "xзфыыцщкв",
+			want: []*match.Match{{
+				Pattern: "keyboard_layout", I: 1, J: 16, Token: "зфыыцщкв",
+				MatchedWord: "password", Rank: 1, DictionaryName: "d1",
+				SourceLayout: "russian", TargetLayout: "qwerty", LayoutPairs: 2,
+			}},
+		},
+		{
+			password: "πασσςορδ",
+			want: []*match.Match{{
+				Pattern: "keyboard_layout", I: 0, J: 15, Token: "πασσςορδ",
+				MatchedWord: "password", Rank: 1, DictionaryName: "d1",
+				SourceLayout: "greek", TargetLayout: "qwerty", LayoutPairs: 2,
+			}},
+		},
+		// words typed in the right layout are left to the dictionary matcher
+		{password: "password"},
+		{password: "мир"},
+	} {
+		assert.Equal(t, tt.want, km.Matches(tt.password), tt.password)
+	}
+}
+
+func TestKeyboardLayoutMatchingDictionaries(t *testing.T) {
+	layoutWords := func(opts Options, password string) []string {
+		var words []string
+		for _, m := range Omnimatch(password, nil, opts) {
+			if m.Pattern == "keyboard_layout" {
+				words = append(words, m.DictionaryName+":"+m.MatchedWord)
+			}
+		}
+		return words
+	}
+	opts := testOptions
+	opts.KeyboardLayouts = DefaultKeyboardLayoutPairs
+	assert.NotContains(t, layoutWords(opts, "ghbdtn"), "russian_words:привет")
+
+	opts.Dictionaries = map[string]*RankedDictionary{
+		"russian_words": NewRankedDictionary([]string{"Привет", "мир"}),
+	}
+	assert.Contains(t, layoutWords(opts, "ghbdtn"), "russian_words:привет")
+	// the extra dictionaries are matched by the other matchers too
+	var found bool
+	for _, m := range Omnimatch("мир", nil, opts) {
+		found = found || (m.Pattern == "dictionary" && m.DictionaryName == "russian_words")
+	}
+	assert.True(t, found)
+}
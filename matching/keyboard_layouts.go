@@ -0,0 +1,91 @@
+package matching
+
+import (
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+)
+
+// KeyboardLayoutPair maps the characters typed by the keys of a keyboard layout to
+// the characters the same keys type in another layout: with the QWERTY/ЙЦУКЕН pair,
+// "ghbdtn" is "привет" typed with the keyboard left in the Latin layout.
+// Pairs are validated by NewKeyboardLayoutPair and can't be changed afterwards.
+type KeyboardLayoutPair struct {
+	from, to string
+	// forward maps the characters of from to those of to, backward the other way.
+	forward, backward map[rune]rune
+}
+
+// NewKeyboardLayoutPair returns the pair of layouts named from and to, where the
+// n-th character of fromKeys and of toKeys are typed by the same key. Characters
+// are lowercase and appear once per layout.
+func NewKeyboardLayoutPair(from, to, fromKeys, toKeys string) (*KeyboardLayoutPair, error) {
+	if from == "" || to == "" || from == to {
+		return nil, fmt.Errorf("keyboard layout pair: invalid layout names %q and %q", from, to)
+	}
+	fk, tk := []rune(fromKeys), []rune(toKeys)
+	if !utf8.ValidString(fromKeys) || !utf8.ValidString(toKeys) || len(fk) != len(tk) {
+		return nil, fmt.Errorf("keyboard layout pair %s/%s: keys don't match", from, to)
+	}
+	p := &KeyboardLayoutPair{
+		from:     from,
+		to:       to,
+		forward:  make(map[rune]rune, len(fk)),
+		backward: make(map[rune]rune, len(tk)),
+	}
+	for n := range fk {
+		f, t := fk[n], tk[n]
+		switch {
+		case unicode.ToLower(f) != f || unicode.ToLower(t) != t:
+			return nil, fmt.Errorf("keyboard layout pair %s/%s: %q or %q is not lowercase", from, to, f, t)
+		case f == t:
+			return nil, fmt.Errorf("keyboard layout pair %s/%s: %q is the same in both layouts", from, to, f)
+		}
+		if _, ok := p.forward[f]; ok {
+			return nil, fmt.Errorf("keyboard layout pair %s/%s: duplicate key %q", from, to, f)
+		}
+		if _, ok := p.backward[t]; ok {
+			return nil, fmt.Errorf("keyboard layout pair %s/%s: duplicate key %q", from, to, t)
+		}
+		p.forward[f] = t
+		p.backward[t] = f
+	}
+	return p, nil
+}
+
+func mustKeyboardLayoutPair(from, to, fromKeys, toKeys string) *KeyboardLayoutPair {
+	p, err := NewKeyboardLayoutPair(from, to, fromKeys, toKeys)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// Layouts returns the names of the layouts of the pair.
+func (p *KeyboardLayoutPair) Layouts() (from, to string) {
+	return p.from, p.to
+}
+
+var (
+	// QwertyRussianLayouts pairs QWERTY with the Russian ЙЦУКЕН layout.
+	QwertyRussianLayouts = mustKeyboardLayoutPair("qwerty", "russian",
+		"`qwertyuiop[]asdfghjkl;'zxcvbnm,.",
+		"ёйцукенгшщзхъфывапролджэячсмитьбю")
+
+	// QwertyGreekLayouts pairs QWERTY with the Greek layout.
+	QwertyGreekLayouts = mustKeyboardLayoutPair("qwerty", "greek",
+		"wertyuiopasdfghjklzxcvbnm",
+		"ςερτυθιοπασδφγηξκλζχψωβνμ")
+
+	// QwertyHebrewLayouts pairs QWERTY with the Hebrew SI-1452 layout.
+	QwertyHebrewLayouts = mustKeyboardLayoutPair("qwerty", "hebrew",
+		"ertyuiopasdfghjkl;zxcvbnm,.",
+		"קראטוןםפשדגכעיחלךףזסבהנמצתץ")
+
+	// DefaultKeyboardLayoutPairs are the shipped pairs of layouts.
+	DefaultKeyboardLayoutPairs = []*KeyboardLayoutPair{
+		QwertyRussianLayouts,
+		QwertyGreekLayouts,
+		QwertyHebrewLayouts,
+	}
+)
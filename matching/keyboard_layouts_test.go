@@ -0,0 +1,45 @@
+package matching
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewKeyboardLayoutPair(t *testing.T) {
+	p, err := NewKeyboardLayoutPair("qwerty", "russian", "qwe", "йцу")
+	require.NoError(t, err)
+	from, to := p.Layouts()
+	assert.Equal(t, "qwerty", from)
+	assert.Equal(t, "russian", to)
+	assert.Equal(t, map[rune]rune{'q': 'й', 'w': 'ц', 'e': 'у'}, p.forward)
+	assert.Equal(t, map[rune]rune{'й': 'q', 'ц': 'w', 'у': 'e'}, p.backward)
+
+	for _, invalid := range [][4]string{
+		{"", "russian", "q", "й"},
+		{"qwerty", "qwerty", "q", "й"},
+		{"qwerty", "russian", "qw", "й"},
+		{"qwerty", "russian", "Q", "й"},
+		{"qwerty", "russian", "q", "Й"},
+		{"qwerty", "russian", "q1", "й1"},
+		{"qwerty", "russian", "qq", "йц"},
+		{"qwerty", "russian", "qw", "йй"},
+		{"qwerty", "russian", "\xff", "й"},
+	} {
+		_, err := NewKeyboardLayoutPair(invalid[0], invalid[1], invalid[2], invalid[3])
+		assert.Error(t, err, "%q", invalid)
+	}
+}
+
+func TestShippedKeyboardLayoutPairs(t *testing.T) {
+	for _, p := range DefaultKeyboardLayoutPairs {
+		assert.Equal(t, len(p.forward), len(p.backward))
+	}
+	mapped, _ := mapKeys("ghbdtn", QwertyRussianLayouts.forward)
+	assert.Equal(t, "привет", mapped)
+	mapped, _ = mapKeys("dhf", QwertyGreekLayouts.forward)
+	assert.Equal(t, "δηφ", mapped)
+	mapped, _ = mapKeys("akuo", QwertyHebrewLayouts.forward)
+	assert.Equal(t, "שלום", mapped)
+}
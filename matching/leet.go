@@ -1,165 +1,360 @@
 package matching
 
 import (
-	"bytes"
-	// "github.com/trustelem/zxcvbn/entropy"
-	"github.com/trustelem/zxcvbn/match"
 	"sort"
+	"strconv"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/trustelem/zxcvbn/match"
 )
 
 type l33tMatch struct {
 	dm    dictionaryMatch
 	table map[string][]string
+	// maxSubs caps the number of sets of substitutions tried from each position, if positive.
+	maxSubs int
 }
 
-func (lm l33tMatch) Matches(password string) []*match.Match {
-	matches := []*match.Match{}
+// upstream enumerates every set of substitutions that a password might be using, and runs
+// the dictionary matcher on the password translated with each of them: that is exponential
+// in the number of l33t characters of the password.
+//
+// instead, the password is translated from each position, deciding what each substitution
+// stands for when it's first met, and the translation stops as soon as it starts no
+// dictionary word. decisions are only kept if one of the sets of substitutions enumerated by
+// upstream makes them, so that the matches are the same.
 
-	substitutions := relevantSubtable(password, lm.table)
+func (lm l33tMatch) Matches(password string) []*match.Match {
+	s := newL33tSearch(lm, password)
+	if len(s.letters) > 0 {
+		for i := range password {
+			s.searchFrom(i)
+		}
+	}
 
-	for _, sub := range enumerateLeetSubs(substitutions) {
-		if len(sub) == 0 {
-			break
+	sort.SliceStable(s.matches, func(a, b int) bool {
+		ma, mb := s.matches[a], s.matches[b]
+		if ma.DictionaryName != mb.DictionaryName {
+			return ma.DictionaryName < mb.DictionaryName
 		}
-		subbedPassword := translate(password, sub)
-		for _, m := range lm.dm.Matches(subbedPassword) {
-			token := password[m.I : m.J+1]
-			if len(token) <= 1 {
-				// filter single-character l33t matches to reduce noise.
-				// otherwise '1' matches 'i', '4' matches 'a', both very common English words
-				continue
-			}
+		return ma.MatchedWord < mb.MatchedWord
+	})
+	match.Sort(s.matches)
+	return s.matches
+}
 
-			if strings.ToLower(token) == m.MatchedWord {
-				continue // only return the matches that return an actual substitution
+type l33tSearch struct {
+	lm       l33tMatch
+	password string
+	words    []sortedWords
+
+	// the relevant subtable, its letters sorted, and the letters each substitution stands for
+	table      map[string][]string
+	letters    []string
+	keyLetters map[string][]int
+
+	// candidates[p] are the substitutions found at password[p:], longest first.
+	candidates [][]string
+	// covered[p] is true if a substitution found in the password starts before p and ends after.
+	covered []bool
+
+	// decided maps the substitutions met so far to the index of the letter they stand for,
+	// or to -1 if they're kept as is.
+	decided map[string]int
+	// sets maps the sorted substitutions of decided to the decisions that upstream can make.
+	sets map[string]map[string]bool
+
+	// the translation in progress: it starts at start, and spends budget on alternatives.
+	// capped is set when the budget runs out.
+	start      int
+	budget     int
+	capped     bool
+	translated []byte
+	used       []string
+
+	matches []*match.Match
+	found   map[dictionaryMatchKey]bool
+}
+
+func newL33tSearch(lm l33tMatch, password string) *l33tSearch {
+	s := &l33tSearch{
+		lm:         lm,
+		password:   password,
+		words:      lm.dm.prefixIndex(),
+		table:      relevantSubtable(password, lm.table),
+		keyLetters: make(map[string][]int),
+		candidates: make([][]string, len(password)),
+		covered:    make([]bool, len(password)+1),
+		decided:    make(map[string]int),
+		sets:       make(map[string]map[string]bool),
+		matches:    []*match.Match{},
+		found:      make(map[dictionaryMatchKey]bool),
+	}
+	for letter := range s.table {
+		s.letters = append(s.letters, letter)
+	}
+	sort.Strings(s.letters)
+
+	var keys []string
+	for l, letter := range s.letters {
+		for _, k := range s.table[letter] {
+			if len(s.keyLetters[k]) == 0 {
+				keys = append(keys, k)
 			}
-			m.Sub = make(map[string]string)
-			for subbed, chr := range sub {
-				if strings.Contains(token, subbed) {
-					m.Sub[subbed] = chr
+			s.keyLetters[k] = append(s.keyLetters[k], l)
+		}
+	}
+	// the longest substitution wins, as in translate
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) > len(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+	for p := range password {
+		for _, k := range keys {
+			if strings.HasPrefix(password[p:], k) {
+				s.candidates[p] = append(s.candidates[p], k)
+				for c := p + 1; c < p+len(k); c++ {
+					s.covered[c] = true
 				}
 			}
-			m.L33t = true
-			m.Token = token
-			matches = append(matches, m)
 		}
+	}
+	return s
+}
 
+// searchFrom adds the l33t matches starting at password[i:].
+func (s *l33tSearch) searchFrom(i int) {
+	s.start = i
+	s.budget = s.lm.maxSubs - 1
+	s.capped = false
+	n := len(s.matches)
+	// a multi-character substitution may hide i: the translation starts before it,
+	// at a position that's never inside a substitution.
+	from := i
+	for s.covered[from] {
+		from--
 	}
+	s.scan(from, 0)
+	if s.capped {
+		for _, m := range s.matches[n:] {
+			m.L33tCapped = true
+		}
+	}
+}
 
-	match.Sort(matches)
-	return matches
+// scan translates the password from pos, after the candidate substitutions of pos
+// before ci have been kept as is.
+func (s *l33tSearch) scan(pos, ci int) {
+	if pos == len(s.password) {
+		return
+	}
+	candidates := s.candidates[pos]
+	for ; ci < len(candidates); ci++ {
+		k := candidates[ci]
+		l, ok := s.decided[k]
+		if !ok {
+			s.decide(pos, ci)
+			return
+		}
+		if l >= 0 {
+			s.consume(pos, k, s.letters[l])
+			return
+		}
+	}
+	_, size := utf8.DecodeRuneInString(s.password[pos:])
+	s.consume(pos, "", s.password[pos:pos+size])
 }
 
-func translate(password string, sub map[string]string) string {
-	var res string
-	for _, s := range password {
-		if v, ok := sub[string(s)]; ok {
-			res = res + v
-		} else {
-			res = res + string(s)
+// decide tries the letters the substitution candidates[pos][ci] stands for, then keeping it as is.
+func (s *l33tSearch) decide(pos, ci int) {
+	k := s.candidates[pos][ci]
+	defer delete(s.decided, k)
+	first := true
+	spend := func() bool {
+		if first {
+			first = false
+			return true
+		}
+		if s.budget == 0 {
+			s.capped = true
+			return false
+		}
+		s.budget--
+		return true
+	}
+	for _, l := range s.keyLetters[k] {
+		s.decided[k] = l
+		if s.valid() {
+			if !spend() {
+				return
+			}
+			s.consume(pos, k, s.letters[l])
 		}
 	}
-	return res
+	s.decided[k] = -1
+	if s.valid() && spend() {
+		s.scan(pos, ci+1)
+	}
 }
 
-type kv struct {
-	k string
-	v string
+// consume adds value to the translation, for the substitution k at pos, or for the
+// character at pos if k is empty.
+func (s *l33tSearch) consume(pos int, k, value string) {
+	next := pos + len(value)
+	if k != "" {
+		next = pos + len(k)
+	}
+	if next <= s.start {
+		s.scan(next, 0)
+		return
+	}
+	if pos < s.start {
+		// the translation goes past the start of the match
+		return
+	}
+	n, u := len(s.translated), len(s.used)
+	s.translated = append(s.translated, strings.ToLower(value)...)
+	if k != "" {
+		s.used = append(s.used, k)
+	}
+	word := string(s.translated)
+	if s.hasPrefix(word) {
+		s.lookup(word, next-1)
+		s.scan(next, 0)
+	}
+	s.translated, s.used = s.translated[:n], s.used[:u]
 }
 
-func dedup(subs [][]kv) [][]kv {
-	var res [][]kv
-	var b bytes.Buffer
-	members := make(map[string]bool)
-	for _, sub := range subs {
-		sort.SliceStable(sub, func(i, j int) bool {
-			return sub[i].k < sub[j].k
-		})
-		b.Reset()
-		for _, x := range sub {
-			b.WriteString(x.k)
-			b.WriteString(",")
-			b.WriteString(x.v)
+func (s *l33tSearch) hasPrefix(prefix string) bool {
+	for _, w := range s.words {
+		if w.hasPrefix(prefix) {
+			return true
 		}
-		key := b.String()
-		if !members[key] {
-			res = append(res, sub)
-			members[key] = true
+	}
+	return false
+}
+
+// lookup adds the matches of word, translated from password[start:j+1].
+func (s *l33tSearch) lookup(word string, j int) {
+	if len(word) <= 1 {
+		// filter single-character l33t matches to reduce noise.
+		// otherwise '1' matches 'i', '4' matches 'a', both very common English words
+		return
+	}
+	token := s.password[s.start : j+1]
+	if strings.ToLower(token) == word {
+		return // only return the matches that return an actual substitution
+	}
+	for dictionaryName, rankedDict := range s.lm.dm.rankedDictionaries {
+		rank, ok := rankedDict[word]
+		if !ok {
+			continue
 		}
+		key := dictionaryMatchKey{i: s.start, j: j, dictionaryName: dictionaryName, word: word}
+		if s.found[key] {
+			continue
+		}
+		s.found[key] = true
+		sub := make(map[string]string, len(s.used))
+		for _, k := range s.used {
+			sub[k] = s.letters[s.decided[k]]
+		}
+		s.matches = append(s.matches, &match.Match{
+			Pattern:        "dictionary",
+			I:              s.start,
+			J:              j,
+			Token:          token,
+			MatchedWord:    word,
+			Rank:           rank,
+			DictionaryName: dictionaryName,
+			L33t:           true,
+			Sub:            sub,
+		})
 	}
-	return res
 }
 
-// enumerateLeetSubs returns the list of possible 1337 replacement dictionaries for a given password
-func enumerateLeetSubs(table map[string][]string) []map[string]string {
-	var keys []string
-	for k := range table {
+// valid reports whether one of the sets of substitutions enumerated by upstream makes
+// the current decisions.
+func (s *l33tSearch) valid() bool {
+	keys := make([]string, 0, len(s.decided))
+	for k := range s.decided {
 		keys = append(keys, k)
 	}
 	sort.Strings(keys)
-	var subs = [][]kv{[]kv{}}
+	id := strings.Join(keys, "\x00")
+	sets, ok := s.sets[id]
+	if !ok {
+		sets = s.enumerate(keys)
+		s.sets[id] = sets
+	}
+	state := make([]int, len(keys))
+	for i, k := range keys {
+		state[i] = s.decided[k]
+	}
+	return sets[l33tStateID(state)]
+}
 
-	var helper func(keys []string)
-	helper = func(keys []string) {
-		if len(keys) == 0 {
-			return
+// enumerate returns the sets of substitutions that upstream enumerates, restricted to keys.
+// upstream goes through the letters in order: each letter takes each of its substitutions
+// in turn, and if the substitution was taken by a previous letter, the letter either goes
+// without any or takes it from the previous letter. only the substitutions of keys are
+// tracked here: the others just leave the letter without any of keys.
+func (s *l33tSearch) enumerate(keys []string) map[string]bool {
+	index := make(map[string]int, len(keys))
+	initial := make([]int, len(keys))
+	for i, k := range keys {
+		index[k] = i
+		initial[i] = -1
+	}
+	states := [][]int{initial}
+	for l, letter := range s.letters {
+		var next [][]int
+		seen := make(map[string]bool)
+		add := func(state []int) {
+			if id := l33tStateID(state); !seen[id] {
+				seen[id] = true
+				next = append(next, state)
+			}
 		}
-		firstKey := keys[0]
-		restKeys := keys[1:]
-		var nextSubs [][]kv
-		for _, l33tChr := range table[firstKey] {
-			for _, sub := range subs {
-				dupL33tIndex := -1
-				for i := 0; i < len(sub); i++ {
-					if sub[i].k == l33tChr {
-						dupL33tIndex = i
-						break
-					}
+		for _, k := range s.table[letter] {
+			i, tracked := index[k]
+			for _, state := range states {
+				if !tracked || state[i] >= 0 {
+					add(state)
 				}
-				if dupL33tIndex == -1 {
-					subExtension := append(sub, kv{k: l33tChr, v: firstKey})
-					nextSubs = append(nextSubs, subExtension)
-				} else {
-					subAlternative := make([]kv, 0, len(sub))
-					subAlternative = append(subAlternative, sub[0:dupL33tIndex]...)
-					subAlternative = append(subAlternative, sub[dupL33tIndex+1:]...)
-					subAlternative = append(subAlternative, kv{k: l33tChr, v: firstKey})
-					// subAlternative := make([]kv, 0, len(sub))
-					// subAlternative = append(subAlternative, sub)
-					// subAlternative[dupL33tIndex] = {k:l33tChr,v:firstKey}
-					nextSubs = append(nextSubs, sub)
-					nextSubs = append(nextSubs, subAlternative)
+				if tracked {
+					taken := append([]int(nil), state...)
+					taken[i] = l
+					add(taken)
 				}
 			}
 		}
-		subs = dedup(nextSubs)
-		helper(restKeys)
+		states = next
 	}
-
-	helper(keys)
-	var subDicts []map[string]string
-	for _, sub := range subs {
-		subDict := make(map[string]string)
-
-		for _, x := range sub {
-			subDict[x.k] = x.v
-		}
-		subDicts = append(subDicts, subDict)
+	sets := make(map[string]bool, len(states))
+	for _, state := range states {
+		sets[l33tStateID(state)] = true
 	}
-	return subDicts
+	return sets
 }
 
-func relevantSubtable(password string, table map[string][]string) map[string][]string {
-	passwordChars := make(map[rune]bool)
-	for _, chr := range password {
-		passwordChars[chr] = true
+func l33tStateID(state []int) string {
+	b := make([]byte, 0, 3*len(state))
+	for _, l := range state {
+		b = strconv.AppendInt(b, int64(l), 10)
+		b = append(b, ',')
 	}
+	return string(b)
+}
 
+func relevantSubtable(password string, table map[string][]string) map[string][]string {
 	relevantSubs := make(map[string][]string)
 	for key, values := range table {
 		for _, value := range values {
-			if passwordChars[rune(value[0])] {
+			if strings.Contains(password, value) {
 				relevantSubs[key] = append(relevantSubs[key], value)
 			}
 		}
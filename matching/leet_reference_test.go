@@ -0,0 +1,202 @@
+package matching
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+
+	"github.com/trustelem/zxcvbn/match"
+)
+
+// this is the l33t matcher of upstream: every set of substitutions that a password
+// might be using is enumerated, and the dictionary matcher runs on the password
+// translated with each of them. l33tMatch must find the same matches.
+
+func referenceL33tMatches(lm l33tMatch, password string) []*match.Match {
+	matches := []*match.Match{}
+
+	substitutions := relevantSubtable(password, lm.table)
+
+	for _, sub := range enumerateLeetSubs(substitutions) {
+		if len(sub) == 0 {
+			break
+		}
+		t := translate(password, sub)
+		for _, m := range lm.dm.Matches(t.password) {
+			if len(m.Token) <= 1 {
+				// filter single-character l33t matches to reduce noise.
+				// otherwise '1' matches 'i', '4' matches 'a', both very common English words
+				continue
+			}
+			i, j := t.offsets[m.I], t.offsets[m.J+1]-1
+			token := password[i : j+1]
+
+			if strings.ToLower(token) == m.MatchedWord {
+				continue // only return the matches that return an actual substitution
+			}
+			m.Sub = make(map[string]string)
+			for k := m.I; k <= m.J; k++ {
+				if subbed := t.subbed[k]; subbed != "" {
+					m.Sub[subbed] = sub[subbed]
+				}
+			}
+			m.L33t = true
+			m.I, m.J = i, j
+			m.Token = token
+			matches = append(matches, m)
+		}
+
+	}
+
+	match.Sort(matches)
+	return matches
+}
+
+// l33tTranslation is a password with l33t substitutions replaced by the letters they
+// stand for. Substitutions can be several characters long ("|_|" for u), so the
+// translated password is mapped back to the original one.
+type l33tTranslation struct {
+	password string
+	// offsets[k] is the index in the original password of the k-th byte of the
+	// translated password, followed by the length of the original password.
+	offsets []int
+	// subbed[k] is the substitution that produced the k-th byte, or "".
+	subbed []string
+}
+
+// translate replaces the substitutions of sub in password, preferring the longest
+// substitution at each position.
+func translate(password string, sub map[string]string) l33tTranslation {
+	keys := make([]string, 0, len(sub))
+	for k := range sub {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) > len(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+
+	var b strings.Builder
+	b.Grow(len(password))
+	t := l33tTranslation{
+		offsets: make([]int, 0, len(password)+1),
+		subbed:  make([]string, 0, len(password)),
+	}
+	for i := 0; i < len(password); {
+		subbed := ""
+		for _, k := range keys {
+			if strings.HasPrefix(password[i:], k) {
+				subbed = k
+				break
+			}
+		}
+		if subbed == "" {
+			t.offsets = append(t.offsets, i)
+			t.subbed = append(t.subbed, "")
+			b.WriteByte(password[i])
+			i++
+			continue
+		}
+		v := sub[subbed]
+		for k := 0; k < len(v); k++ {
+			t.offsets = append(t.offsets, i)
+			t.subbed = append(t.subbed, subbed)
+		}
+		b.WriteString(v)
+		i += len(subbed)
+	}
+	t.offsets = append(t.offsets, len(password))
+	t.password = b.String()
+	return t
+}
+
+type kv struct {
+	k string
+	v string
+}
+
+func dedup(subs [][]kv) [][]kv {
+	var res [][]kv
+	var b bytes.Buffer
+	members := make(map[string]bool)
+	for _, sub := range subs {
+		sort.SliceStable(sub, func(i, j int) bool {
+			return sub[i].k < sub[j].k
+		})
+		b.Reset()
+		for _, x := range sub {
+			b.WriteString(x.k)
+			b.WriteString(",")
+			b.WriteString(x.v)
+		}
+		key := b.String()
+		if !members[key] {
+			res = append(res, sub)
+			members[key] = true
+		}
+	}
+	return res
+}
+
+// enumerateLeetSubs returns the list of possible 1337 replacement dictionaries for a given password
+func enumerateLeetSubs(table map[string][]string) []map[string]string {
+	var keys []string
+	for k := range table {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var subs = [][]kv{[]kv{}}
+
+	var helper func(keys []string)
+	helper = func(keys []string) {
+		if len(keys) == 0 {
+			return
+		}
+		firstKey := keys[0]
+		restKeys := keys[1:]
+		var nextSubs [][]kv
+		for _, l33tChr := range table[firstKey] {
+			for _, sub := range subs {
+				dupL33tIndex := -1
+				for i := 0; i < len(sub); i++ {
+					if sub[i].k == l33tChr {
+						dupL33tIndex = i
+						break
+					}
+				}
+				if dupL33tIndex == -1 {
+					// copy sub: appending to it would share its array between extensions,
+					// and lose some of them
+					subExtension := append(append([]kv{}, sub...), kv{k: l33tChr, v: firstKey})
+					nextSubs = append(nextSubs, subExtension)
+				} else {
+					subAlternative := make([]kv, 0, len(sub))
+					subAlternative = append(subAlternative, sub[0:dupL33tIndex]...)
+					subAlternative = append(subAlternative, sub[dupL33tIndex+1:]...)
+					subAlternative = append(subAlternative, kv{k: l33tChr, v: firstKey})
+					// subAlternative := make([]kv, 0, len(sub))
+					// subAlternative = append(subAlternative, sub)
+					// subAlternative[dupL33tIndex] = {k:l33tChr,v:firstKey}
+					nextSubs = append(nextSubs, sub)
+					nextSubs = append(nextSubs, subAlternative)
+				}
+			}
+		}
+		subs = dedup(nextSubs)
+		helper(restKeys)
+	}
+
+	helper(keys)
+	var subDicts []map[string]string
+	for _, sub := range subs {
+		subDict := make(map[string]string)
+
+		for _, x := range sub {
+			subDict[x.k] = x.v
+		}
+		subDicts = append(subDicts, subDict)
+	}
+	return subDicts
+}
@@ -0,0 +1,150 @@
+package matching
+
+import (
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+)
+
+// L33tTable maps letters to the l33t substitutions that stand for them.
+// Tables are validated by NewL33tTable and can't be changed afterwards.
+type L33tTable struct {
+	subs map[string][]string
+}
+
+// DefaultMaxL33tSubs is the number of sets of l33t substitutions tried from each position
+// of a password when Options.MaxL33tSubs is zero.
+const DefaultMaxL33tSubs = 1024
+
+// NewL33tTable returns a table with the substitutions of subs, keyed by letter.
+// Letters must be single lowercase letters; substitutions must be non-empty, must
+// not contain the letter they stand for and must appear once per letter. The same
+// substitution may stand for several letters ("1" for i and l).
+func NewL33tTable(subs map[string][]string) (*L33tTable, error) {
+	t := &L33tTable{subs: make(map[string][]string, len(subs))}
+	for letter, values := range subs {
+		r, size := utf8.DecodeRuneInString(letter)
+		if size == 0 || size != len(letter) || r == utf8.RuneError || !unicode.IsLower(r) {
+			return nil, fmt.Errorf("l33t table: %q is not a lowercase letter", letter)
+		}
+		seen := make(map[string]bool, len(values))
+		for _, v := range values {
+			switch {
+			case v == "" || !utf8.ValidString(v):
+				return nil, fmt.Errorf("l33t table: invalid substitution %q for %q", v, letter)
+			case containsFold(v, letter):
+				return nil, fmt.Errorf("l33t table: substitution %q for %q contains the letter", v, letter)
+			case seen[v]:
+				return nil, fmt.Errorf("l33t table: duplicate substitution %q for %q", v, letter)
+			}
+			seen[v] = true
+		}
+		t.subs[letter] = append([]string(nil), values...)
+	}
+	return t, nil
+}
+
+func mustL33tTable(subs map[string][]string) *L33tTable {
+	t, err := NewL33tTable(subs)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// MergeL33tTables returns a table with the substitutions of all tables, in order.
+func MergeL33tTables(tables ...*L33tTable) *L33tTable {
+	merged := &L33tTable{subs: make(map[string][]string)}
+	for _, t := range tables {
+		for letter, values := range t.subs {
+			for _, v := range values {
+				if !contains(merged.subs[letter], v) {
+					merged.subs[letter] = append(merged.subs[letter], v)
+				}
+			}
+		}
+	}
+	return merged
+}
+
+// Substitutions returns a copy of the substitutions of the table, keyed by letter,
+// to be extended and passed to NewL33tTable.
+func (t *L33tTable) Substitutions() map[string][]string {
+	subs := make(map[string][]string, len(t.subs))
+	for letter, values := range t.subs {
+		subs[letter] = append([]string(nil), values...)
+	}
+	return subs
+}
+
+func containsFold(s, letter string) bool {
+	for _, r := range s {
+		if string(unicode.ToLower(r)) == letter {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(values []string, v string) bool {
+	for _, x := range values {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultL33tTable is the table of upstream zxcvbn.
+	DefaultL33tTable = mustL33tTable(map[string][]string{
+		"a": {"4", "@"},
+		"b": {"8"},
+		"c": {"(", "{", "[", "<"},
+		"e": {"3"},
+		"g": {"6", "9"},
+		"i": {"1", "!", "|"},
+		"l": {"1", "|", "7"},
+		"o": {"0"},
+		"s": {"$", "5"},
+		"t": {"+", "7"},
+		"x": {"%"},
+		"z": {"2"},
+	})
+
+	// ExtendedL33tTable adds ASCII art substitutions to DefaultL33tTable, such as
+	// "|_|" for u, "ph" for f or "vv" for w.
+	ExtendedL33tTable = MergeL33tTables(DefaultL33tTable, mustL33tTable(map[string][]string{
+		"a": {"/-\\", "^"},
+		"b": {"|3", "13"},
+		"d": {"|)"},
+		"e": {"&"},
+		"f": {"ph", "|="},
+		"h": {"#", "|-|", "}{"},
+		"k": {"|<", "|{"},
+		"m": {"|\\/|", "/\\/\\", "|v|"},
+		"n": {"|\\|", "/\\/"},
+		"o": {"()", "[]"},
+		"p": {"|*", "|>"},
+		"r": {"|2"},
+		"u": {"|_|", "(_)"},
+		"v": {"\\/"},
+		"w": {"vv", "\\/\\/", "\\^/"},
+		"x": {"><", "}{"},
+		"y": {"`/"},
+	}))
+
+	// EuropeanL33tTable adds the substitutions of European keyboards to
+	// DefaultL33tTable, such as "€" for e or "£" for l.
+	EuropeanL33tTable = MergeL33tTables(DefaultL33tTable, mustL33tTable(map[string][]string{
+		"a": {"ª"},
+		"b": {"ß"},
+		"c": {"¢", "©"},
+		"e": {"€"},
+		"l": {"£"},
+		"o": {"°", "º", "ø"},
+		"r": {"®"},
+		"s": {"§"},
+		"y": {"¥"},
+	}))
+)
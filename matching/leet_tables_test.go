@@ -0,0 +1,109 @@
+package matching
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewL33tTable(t *testing.T) {
+	table, err := NewL33tTable(map[string][]string{
+		"a": {"4", "@"},
+		"u": {"|_|"},
+		"é": {"€"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{"a": {"4", "@"}, "u": {"|_|"}, "é": {"€"}}, table.Substitutions())
+
+	// the table doesn't share its substitutions
+	subs := table.Substitutions()
+	subs["a"][0] = "x"
+	assert.Equal(t, []string{"4", "@"}, table.Substitutions()["a"])
+
+	for _, invalid := range []map[string][]string{
+		{"": {"4"}},
+		{"ab": {"4"}},
+		{"A": {"4"}},
+		{"1": {"i"}},
+		{"a": {""}},
+		{"a": {"\xff"}},
+		{"a": {"/a"}},
+		{"a": {"/A"}},
+		{"a": {"4", "4"}},
+	} {
+		_, err := NewL33tTable(invalid)
+		assert.Error(t, err, "%q", invalid)
+	}
+}
+
+func TestMergeL33tTables(t *testing.T) {
+	merged := MergeL33tTables(
+		mustL33tTable(map[string][]string{"a": {"4", "@"}, "e": {"3"}}),
+		mustL33tTable(map[string][]string{"a": {"@", "/-\\"}, "u": {"|_|"}}),
+	)
+	assert.Equal(t, map[string][]string{
+		"a": {"4", "@", "/-\\"},
+		"e": {"3"},
+		"u": {"|_|"},
+	}, merged.Substitutions())
+}
+
+func TestShippedL33tTables(t *testing.T) {
+	for _, table := range []*L33tTable{ExtendedL33tTable, EuropeanL33tTable} {
+		// shipped tables extend the default one
+		for letter, values := range DefaultL33tTable.subs {
+			assert.Subset(t, table.subs[letter], values)
+		}
+		_, err := NewL33tTable(table.subs)
+		assert.NoError(t, err)
+	}
+	assert.Contains(t, ExtendedL33tTable.subs["u"], "|_|")
+	assert.Contains(t, EuropeanL33tTable.subs["e"], "€")
+}
+
+func TestOmnimatchL33tTable(t *testing.T) {
+	l33tWords := func(opts Options, password string) []string {
+		var words []string
+		for _, m := range Omnimatch(password, nil, opts) {
+			if m.L33t {
+				words = append(words, m.MatchedWord)
+			}
+		}
+		return words
+	}
+
+	assert.NotContains(t, l33tWords(testOptions, "ph|_|nny"), "funny")
+	opts := testOptions
+	opts.L33tTable = ExtendedL33tTable
+	assert.Contains(t, l33tWords(opts, "ph|_|nny"), "funny")
+
+	assert.NotContains(t, l33tWords(testOptions, "p€nc£l"), "pencil")
+	opts.L33tTable = EuropeanL33tTable
+	assert.Contains(t, l33tWords(opts, "€l€phant"), "elephant")
+
+	// with a single set of substitutions from each position, "1" only stands for i
+	opts.L33tTable = nil
+	assert.Contains(t, l33tWords(opts, "1i1y"), "lily")
+	opts.MaxL33tSubs = 1
+	assert.NotContains(t, l33tWords(opts, "1i1y"), "lily")
+	// a negative cap tries them all
+	opts.MaxL33tSubs = -1
+	assert.Contains(t, l33tWords(opts, "1i1y"), "lily")
+
+	// the matches found where the cap was reached tell so
+	capped := func(opts Options, password string) map[string]bool {
+		words := make(map[string]bool)
+		for _, m := range Omnimatch(password, nil, opts) {
+			if m.L33t {
+				words[m.MatchedWord] = m.L33tCapped
+			}
+		}
+		return words
+	}
+	opts.MaxL33tSubs = 1
+	assert.Equal(t, true, capped(opts, "p4$$w0rd1i1y")["password"])
+	assert.Equal(t, false, capped(opts, "1i1yp@ss")["pass"])
+	opts.MaxL33tSubs = 0
+	assert.Equal(t, false, capped(opts, "p4$$w0rd1i1y")["password"])
+}
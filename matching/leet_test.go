@@ -1,8 +1,10 @@
 package matching
 
 import (
+	"fmt"
 	"github.com/google/go-cmp/cmp"
 	"reflect"
+	"sort"
 	"strconv"
 	"testing"
 
@@ -237,12 +239,168 @@ func Test_l33tMatch(t *testing.T) {
 	assert.Len(t, lm.Matches("4sdf0"), 0)
 }
 
+var testMultiCharl33tTable = map[string][]string{
+	"f": {"ph"},
+	"h": {"|-|", "#"},
+	"i": {"|", "1"},
+	"o": {"0", "()"},
+	"u": {"|_|"},
+	"w": {"vv", "\\/\\/"},
+}
+
+func Test_relevantSubtableMultiChar(t *testing.T) {
+	assert.Equal(t, map[string][]string{"i": {"|"}, "u": {"|_|"}}, relevantSubtable("|_|", testMultiCharl33tTable))
+	assert.Equal(t, map[string][]string{"f": {"ph"}, "o": {"0"}}, relevantSubtable("ph0ne", testMultiCharl33tTable))
+}
+
+func Test_translate(t *testing.T) {
+	for _, tt := range []struct {
+		password string
+		sub      map[string]string
+		want     l33tTranslation
+	}{
+		{
+			password: "p4ss",
+			sub:      map[string]string{"4": "a"},
+			want: l33tTranslation{
+				password: "pass",
+				offsets:  []int{0, 1, 2, 3, 4},
+				subbed:   []string{"", "4", "", ""},
+			},
+		},
+		{
+			password: "ph()n3",
+			sub:      map[string]string{"ph": "f", "()": "o"},
+			want: l33tTranslation{
+				password: "fon3",
+				offsets:  []int{0, 2, 4, 5, 6},
+				subbed:   []string{"ph", "()", "", ""},
+			},
+		},
+		{
+			// the longest substitution wins
+			password: "|_||",
+			sub:      map[string]string{"|": "i", "|_|": "u"},
+			want: l33tTranslation{
+				password: "ui",
+				offsets:  []int{0, 3, 4},
+				subbed:   []string{"|_|", "|"},
+			},
+		},
+		{
+			password: "é4",
+			sub:      map[string]string{"4": "a"},
+			want: l33tTranslation{
+				password: "éa",
+				offsets:  []int{0, 1, 2, 3},
+				subbed:   []string{"", "", "4"},
+			},
+		},
+	} {
+		assert.Equal(t, tt.want, translate(tt.password, tt.sub), tt.password)
+	}
+}
+
+func Test_l33tMatchMultiChar(t *testing.T) {
+	lm := l33tMatch{
+		dm: dictionaryMatch{
+			rankedDictionaries: map[string]rankedDictionnary{
+				"words": {
+					"phone":   1,
+					"fun":     2,
+					"hello":   3,
+					"wow":     4,
+					"pikachu": 5,
+				},
+			},
+		},
+		table: testMultiCharl33tTable,
+	}
+	tests := []struct {
+		password string
+		want     []*match.Match
+	}{
+		{
+			password: "xx|-|ell()",
+			want: []*match.Match{
+				{
+					Pattern:        "dictionary",
+					Token:          "|-|ell()",
+					MatchedWord:    "hello",
+					Rank:           3,
+					DictionaryName: "words",
+					I:              2,
+					J:              9,
+					L33t:           true,
+					Sub:            map[string]string{"|-|": "h", "()": "o"},
+				},
+			},
+		},
+		{
+			password: "ph|_|n",
+			want: []*match.Match{
+				{
+					Pattern:        "dictionary",
+					Token:          "ph|_|n",
+					MatchedWord:    "fun",
+					Rank:           2,
+					DictionaryName: "words",
+					I:              0,
+					J:              5,
+					L33t:           true,
+					Sub:            map[string]string{"ph": "f", "|_|": "u"},
+				},
+			},
+		},
+		{
+			password: "vv0vv",
+			want: []*match.Match{
+				{
+					Pattern:        "dictionary",
+					Token:          "vv0vv",
+					MatchedWord:    "wow",
+					Rank:           4,
+					DictionaryName: "words",
+					I:              0,
+					J:              4,
+					L33t:           true,
+					Sub:            map[string]string{"vv": "w", "0": "o"},
+				},
+			},
+		},
+		{
+			password: "p1kac|-||_|",
+			want: []*match.Match{
+				{
+					Pattern:        "dictionary",
+					Token:          "p1kac|-||_|",
+					MatchedWord:    "pikachu",
+					Rank:           5,
+					DictionaryName: "words",
+					I:              0,
+					J:              10,
+					L33t:           true,
+					Sub:            map[string]string{"1": "i", "|-|": "h", "|_|": "u"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.password, func(t *testing.T) {
+			assert.Equal(t, tt.want, lm.Matches(tt.password))
+		})
+	}
+
+	// a single substituted letter isn't a l33t match, however long the substitution
+	assert.Len(t, lm.Matches("|_| ph"), 0)
+}
+
 func TestDeterministicOutput(t *testing.T) {
 	password := "coRrecth0rseba++ery9.23.2007staple$"
 
 	lm := l33tMatch{
 		dm:    defaultRankedDictionnaries,
-		table: l33tTable,
+		table: DefaultL33tTable.subs,
 	}
 
 	var lastMatches []*match.Match
@@ -256,3 +414,101 @@ func TestDeterministicOutput(t *testing.T) {
 		lastMatches = matches
 	}
 }
+
+// sameL33tMatches checks that l33tMatch finds the matches of the upstream matcher,
+// which may find some of them several times.
+func sameL33tMatches(t *testing.T, lm l33tMatch, password string) bool {
+	want := []*match.Match{}
+	found := make(map[string]bool)
+	for _, m := range referenceL33tMatches(lm, password) {
+		if k := fmt.Sprint(*m); !found[k] {
+			found[k] = true
+			want = append(want, m)
+		}
+	}
+	sortMatches := func(matches []*match.Match) {
+		sort.SliceStable(matches, func(a, b int) bool {
+			return fmt.Sprint(*matches[a]) < fmt.Sprint(*matches[b])
+		})
+	}
+	got := lm.Matches(password)
+	sortMatches(want)
+	sortMatches(got)
+	return assert.Equal(t, want, got, "%q", password)
+}
+
+var l33tWorstCases = []string{
+	"1|71|71|71|71|71|71|7",
+	"|_||_||-||-|()()vv|3|<",
+	"!1|7+$5@4(<[{0%2986",
+	"p@$$w0rd1|7p@$$w0rd1|7p@$$w0rd1|7",
+	"1l1l1l1l1l1l1l1l1l1l1l1l1l1l1l1l1l1l1l1l",
+}
+
+func Test_l33tMatchSameAsUpstream(t *testing.T) {
+	passwords := append([]string{
+		"",
+		"p4ssw0rd",
+		"p@ssw0rd1",
+		"1i1y",
+		"coRrecth0rseba++ery9.23.2007staple$",
+		"Tr0ub4dour&3",
+		"R0$38uD99",
+		"ph|_|nny|-|3ll()",
+		"€l€phant",
+	}, l33tWorstCases...)
+	for _, table := range []*L33tTable{DefaultL33tTable, ExtendedL33tTable, EuropeanL33tTable} {
+		lm := l33tMatch{dm: defaultRankedDictionnaries, table: table.subs}
+		for _, password := range passwords {
+			if len(password) > 24 && table != DefaultL33tTable {
+				continue // too slow upstream
+			}
+			sameL33tMatches(t, lm, password)
+		}
+	}
+}
+
+func FuzzL33tMatch(f *testing.F) {
+	for _, password := range l33tWorstCases {
+		f.Add(password)
+	}
+	f.Add("p@ssw0rd")
+	f.Add("ph|_|nny")
+	lm := l33tMatch{
+		dm: dictionaryMatch{
+			rankedDictionaries: map[string]rankedDictionnary{
+				"words": buildRankedDict([]string{"password", "lily", "little", "fun", "funny", "hello", "wow", "bull", "tilt", "ill", "lit"}),
+				"names": buildRankedDict([]string{"bill", "will", "tim", "phil", "lila"}),
+			},
+		},
+		table: ExtendedL33tTable.subs,
+	}
+	f.Fuzz(func(t *testing.T, password string) {
+		if len(password) > 16 {
+			return // upstream is too slow on long passwords
+		}
+		sameL33tMatches(t, lm, password)
+	})
+}
+
+func BenchmarkL33tMatch(b *testing.B) {
+	lm := l33tMatch{dm: defaultRankedDictionnaries, table: DefaultL33tTable.subs}
+	for _, password := range append([]string{"coRrecth0rseba++ery9.23.2007staple$"}, l33tWorstCases...) {
+		b.Run(password, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				lm.Matches(password)
+			}
+		})
+	}
+}
+
+func BenchmarkReferenceL33tMatch(b *testing.B) {
+	lm := l33tMatch{dm: defaultRankedDictionnaries, table: DefaultL33tTable.subs}
+	for _, password := range append([]string{"coRrecth0rseba++ery9.23.2007staple$"}, l33tWorstCases...) {
+		b.Run(password, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				referenceL33tMatches(lm, password)
+			}
+		})
+	}
+}
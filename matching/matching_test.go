@@ -1,5 +1,10 @@
 package matching
 
+import "github.com/trustelem/zxcvbn/scoring"
+
+// testOptions pins the reference year so that results don't depend on the current date.
+var testOptions = Options{Scorer: scoring.Scorer{ReferenceYear: 2019}}
+
 type patternVariant struct {
 	password string
 	i        int
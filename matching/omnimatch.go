@@ -1,24 +1,257 @@
 package matching
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/trustelem/zxcvbn/adjacency"
 	"github.com/trustelem/zxcvbn/frequency"
+	"github.com/trustelem/zxcvbn/internal/mathutils"
 	"github.com/trustelem/zxcvbn/match"
+	"github.com/trustelem/zxcvbn/scoring"
 	"regexp"
+	"sort"
 )
 
-func Omnimatch(password string, userInputs []string) (matches []*match.Match) {
-	dictMatcher := defaultRankedDictionnaries.withDict("user_inputs", buildRankedDict(userInputs))
+const userInputsDictionaryName = "user_inputs"
+
+// ErrDictionaryName is returned by Options.Validate for Dictionaries named like a
+// default dictionary or "user_inputs".
+var ErrDictionaryName = errors.New("matching: dictionary name already taken")
+
+// Options configures the matchers run by Omnimatch.
+type Options struct {
+	// Scorer analyses the base token of repeat matches. Its ReferenceYear is
+	// also used to pick the most likely reading of ambiguous dates.
+	Scorer scoring.Scorer
+
+	// Dictionaries are frequency lists matched along with the default ones, by the
+	// dictionary_name of their matches, such as the common words of the languages of
+	// KeyboardLayouts. Their names must differ from the default ones and "user_inputs":
+	// see Validate.
+	Dictionaries map[string]*RankedDictionary
+
+	// RecentYearWindow selects the years matched as recent_year around the
+	// reference year. Nil stands for the 119 years before and the reference year itself,
+	// like upstream; an empty YearWindow only matches the reference year.
+	RecentYearWindow *YearWindow
+
+	// TextualDates enables dates written with month and weekday names, such as
+	// "14feb", "march1987" or "friday13th".
+	TextualDates bool
+
+	// DateNames are the names recognised in textual dates. Defaults to EnglishDateNames.
+	DateNames []DateNames
+
+	// ExtendedDates enables two-part dates ("2024-05", "05/24"), ISO 8601 week
+	// dates and date-times ("2024-W18", "20240501T1200") and times of day ("12:30").
+	ExtendedDates bool
+
+	// UnicodeFolding enables dictionary matching of the password folded with NFKC,
+	// without diacritics and with confusable letters replaced: "pässwörd", "passwоrd"
+	// (with a Cyrillic о) or "ｐａｓｓｗｏｒｄ".
+	UnicodeFolding bool
+
+	// L33tTable lists the l33t substitutions matched in dictionary words.
+	// Defaults to DefaultL33tTable.
+	L33tTable *L33tTable
+
+	// MaxL33tSubs caps the number of sets of l33t substitutions tried from each position
+	// of a password: past MaxL33tSubs, l33t characters only stand for the first letter
+	// they can, so some l33t words may be missed, and the l33t matches found from that
+	// position have L33tCapped set. Defaults to DefaultMaxL33tSubs; a negative value
+	// removes the cap.
+	MaxL33tSubs int
+
+	// MaxEditDistance enables dictionary matching with typos, such as "pasword" or
+	// "iloveyuo": tokens within this Damerau-Levenshtein distance of a word (1 or 2)
+	// are matched, if the word has at least 4 letters per typo.
+	MaxEditDistance int
+
+	// Abbreviations enables dictionary matching of words written without their vowels,
+	// such as "psswrd" or "drgn", and of common phonetic abbreviations, such as "luv"
+	// or "gr8".
+	Abbreviations bool
+
+	// KeyboardLayouts enables dictionary matching of words typed with the keyboard in
+	// the wrong layout, such as "ghbdtn" for "привет", with these pairs of layouts:
+	// DefaultKeyboardLayoutPairs or a selection of them.
+	KeyboardLayouts []*KeyboardLayoutPair
+
+	// T9 enables dictionary matching of words typed on a phone keypad, one digit per
+	// letter, such as "7277" for "pass".
+	T9 bool
+
+	// Passphrases enables matching of passphrases: 3 words or more separated by spaces
+	// or punctuation, such as "correct-horse-battery-staple", from the dictionaries or
+	// the diceware and EFF word lists.
+	Passphrases bool
+
+	// SeparatedTokens enables matching of tokens joined by a repeated separator, such as
+	// "john_smith_1985": the tokens are scored on their own and the separator is paid
+	// for once.
+	SeparatedTokens bool
+
+	// Palindromes enables matching of mirrored tokens, whose second half reverses the
+	// first, maybe around a pivot character: "abccba", "1234321" or "passssap".
+	Palindromes bool
+
+	// VariantRepeats enables matching of repeats whose repetitions differ by case, l33t
+	// substitutions or reversal, such as "abcABC", "passP4SS" or "dog!DOG!".
+	VariantRepeats bool
+
+	// SpatialVariants enables matching of keyboard walks skipping keys ("qeteu"),
+	// pressing each key several times ("qqwweerr") or switching between a keyboard and
+	// a keypad ("qwe789"), as spatial matches of another SpatialKind.
+	SpatialVariants bool
+
+	// PIN matches numeric PINs instead of passwords: common PINs, keypad patterns,
+	// repeats, sequences, years and dates, and words typed on a phone keypad with T9.
+	// The other matchers and userInputs are left out.
+	PIN bool
+}
+
+// Validate returns an error wrapping ErrDictionaryName if one of o.Dictionaries is
+// named like a default dictionary or "user_inputs". Omnimatch ignores such dictionaries.
+func (o Options) Validate() error {
+	for _, name := range o.dictionaryNames() {
+		if !isFreeDictionaryName(name) {
+			return fmt.Errorf("%w: %q", ErrDictionaryName, name)
+		}
+	}
+	return nil
+}
+
+func isFreeDictionaryName(name string) bool {
+	_, ok := defaultRankedDictionnaries.rankedDictionaries[name]
+	return !ok && name != userInputsDictionaryName
+}
+
+// dictionaryNames returns the names of o.Dictionaries, sorted.
+func (o Options) dictionaryNames() []string {
+	names := make([]string, 0, len(o.Dictionaries))
+	for name := range o.Dictionaries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (o Options) dateNames() []DateNames {
+	if !o.TextualDates {
+		return nil
+	}
+	if len(o.DateNames) == 0 {
+		return []DateNames{EnglishDateNames}
+	}
+	return o.DateNames
+}
+
+// dictionaryMatch returns the matcher of the default dictionaries and o.Dictionaries,
+// leaving out the dictionaries rejected by Validate.
+func (o Options) dictionaryMatch() dictionaryMatch {
+	dm := defaultRankedDictionnaries
+	for _, name := range o.dictionaryNames() {
+		if isFreeDictionaryName(name) {
+			dm = dm.withRankedDictionary(name, o.Dictionaries[name])
+		}
+	}
+	return dm
+}
+
+func (o Options) l33tMatch(dm dictionaryMatch) l33tMatch {
+	table := o.L33tTable
+	if table == nil {
+		table = DefaultL33tTable
+	}
+	maxSubs := o.MaxL33tSubs
+	if maxSubs == 0 {
+		maxSubs = DefaultMaxL33tSubs
+	}
+	return l33tMatch{dm: dm, table: table.subs, maxSubs: maxSubs}
+}
+
+func (o Options) recentYearRegexp() *regexp.Regexp {
+	w := defaultRecentYearWindow
+	if o.RecentYearWindow != nil {
+		w = *o.RecentYearWindow
+	}
+	return recentYearRegexp(o.Scorer.ReferenceYear, w)
+}
+
+func (o Options) recentYearMatch() regexpMatch {
+	return regexpMatch{regexes: []namedRegexp{
+		{Name: "recent_year", Regexp: o.recentYearRegexp()},
+	}}
+}
+
+func (o Options) dateMatch() dateMatch {
+	return dateMatch{
+		referenceYear: o.Scorer.ReferenceYear,
+		names:         o.dateNames(),
+		extended:      o.ExtendedDates,
+	}
+}
+
+// Omnimatch returns the matches found in password by the matchers enabled in opts.
+// Dictionaries of opts rejected by Validate are ignored.
+func Omnimatch(password string, userInputs []string, opts Options) (matches []*match.Match) {
+	if opts.PIN {
+		for _, m := range opts.pinMatchers() {
+			matches = append(matches, m.Matches(password)...)
+		}
+		match.Sort(matches)
+		return matches
+	}
+
+	dictMatcher := opts.dictionaryMatch()
+	if len(userInputs) > 0 {
+		dictMatcher = dictMatcher.withDict(userInputsDictionaryName, buildRankedDict(userInputs))
+	}
 
 	matchers := []match.Matcher{
 		dictMatcher,
 		reverseDictionnaryMatch{dm: dictMatcher},
-		l33tMatch{dm: dictMatcher, table: l33tTable},
+		opts.l33tMatch(dictMatcher),
 		spatialMatch{graphs: defaultGraphs},
-		repeatMatch{},
-		sequenceMatch{},
-		regexpMatch{regexes: defaultRegexpMatch},
-		dateMatch{},
+		repeatMatch{opts: opts},
+		digitNormalizedMatch{sequenceMatch{}},
+		digitNormalizedMatch{opts.recentYearMatch()},
+		digitNormalizedMatch{opts.dateMatch()},
+	}
+
+	if opts.UnicodeFolding {
+		matchers = append(matchers, foldedDictionaryMatch{dm: dictMatcher})
+	}
+	if opts.MaxEditDistance > 0 {
+		matchers = append(matchers, fuzzyDictionaryMatch{
+			dm:          dictMatcher,
+			maxDistance: mathutils.Min(opts.MaxEditDistance, maxEditDistance),
+		})
+	}
+	if opts.Abbreviations {
+		matchers = append(matchers, abbreviationMatch{dm: dictMatcher})
+	}
+	if len(opts.KeyboardLayouts) > 0 {
+		matchers = append(matchers, keyboardLayoutMatch{dm: dictMatcher, pairs: opts.KeyboardLayouts})
+	}
+	if opts.T9 {
+		matchers = append(matchers, digitNormalizedMatch{t9Match{dm: dictMatcher}})
+	}
+	if opts.Passphrases {
+		matchers = append(matchers, passphraseMatch{dm: dictMatcher, wordlists: defaultWordlists})
+	}
+	if opts.SeparatedTokens {
+		matchers = append(matchers, separatedMatch{opts: opts, userInputs: userInputs})
+	}
+	if opts.Palindromes {
+		matchers = append(matchers, palindromeMatch{opts: opts})
+	}
+	if opts.VariantRepeats {
+		matchers = append(matchers, newVariantRepeatMatch(opts))
+	}
+	if opts.SpatialVariants {
+		matchers = append(matchers, spatialVariantMatch{graphs: defaultGraphs, switches: defaultSpatialSwitches})
 	}
 
 	for _, m := range matchers {
@@ -31,38 +264,18 @@ func Omnimatch(password string, userInputs []string) (matches []*match.Match) {
 var (
 	defaultRankedDictionnaries = loadDefaultDictionnaries()
 	defaultGraphs              = loadDefaultAdjacencyGraphs()
-	defaultRegexpMatch         = []struct {
-		Name   string
-		Regexp *regexp.Regexp
-	}{
-		{
-			Name:   "recent_year",
-			Regexp: regexp.MustCompile(`19\d\d|200\d|201\d`),
-		},
-	}
-	l33tTable = map[string][]string{
-		"a": {"4", "@"},
-		"b": {"8"},
-		"c": {"(", "{", "[", "<"},
-		"e": {"3"},
-		"g": {"6", "9"},
-		"i": {"1", "!", "|"},
-		"l": {"1", "|", "7"},
-		"o": {"0"},
-		"s": {"$", "5"},
-		"t": {"+", "7"},
-		"x": {"%"},
-		"z": {"2"},
-	}
 )
 
 func loadDefaultDictionnaries() dictionaryMatch {
 	rd := make(map[string]rankedDictionnary)
+	dicts := make([]rankedDictionnary, 0, len(frequency.FrequencyLists))
 	for n, list := range frequency.FrequencyLists {
 		rd[n] = buildRankedDict(list)
+		dicts = append(dicts, rd[n])
 	}
 	return dictionaryMatch{
 		rankedDictionaries: rd,
+		words:              []sortedWords{newSortedWords(dicts...)},
 	}
 }
 
@@ -9,9 +9,9 @@ import (
 )
 
 func TestOmnimatch(t *testing.T) {
-	assert.Empty(t, Omnimatch("", nil))
+	assert.Empty(t, Omnimatch("", nil, testOptions))
 	password := "r0sebudmaelstrom11/20/91aaaa"
-	matches := Omnimatch(password, nil)
+	matches := Omnimatch(password, nil, testOptions)
 	for _, tt := range []struct {
 		pattern string
 		i       int
@@ -33,7 +33,7 @@ func TestOmnimatch(t *testing.T) {
 	}
 
 	password = "abcde"
-	matches = Omnimatch(password, nil)
+	matches = Omnimatch(password, nil, testOptions)
 	assert.Equal(t, []*match.Match{
 		{
 			Pattern:       "sequence",
@@ -54,7 +54,7 @@ func TestOmnimatch(t *testing.T) {
 	}, matches)
 
 	password = "qwER43@!"
-	matches = Omnimatch(password, nil)
+	matches = Omnimatch(password, nil, testOptions)
 	json.NewEncoder(os.Stdout).Encode(matches)
 	assert.Equal(t, []*match.Match{
 		{
@@ -116,7 +116,7 @@ func TestOmnimatch(t *testing.T) {
 	}, matches)
 
 	password = "eheuczkqyq"
-	matches = Omnimatch(password, nil)
+	matches = Omnimatch(password, nil, testOptions)
 	assert.Equal(t, []*match.Match{
 		{
 			Pattern:        "dictionary",
@@ -140,3 +140,28 @@ func TestOmnimatch(t *testing.T) {
 			L33t:           false},
 	}, matches)
 }
+
+func TestOmnimatchDictionaryNames(t *testing.T) {
+	d := NewRankedDictionary([]string{"flimbertok", "wow"})
+	for _, name := range []string{"us_tv_and_film", "passwords", "user_inputs"} {
+		opts := testOptions
+		opts.Dictionaries = map[string]*RankedDictionary{name: d}
+		assert.ErrorIs(t, opts.Validate(), ErrDictionaryName, name)
+		// the dictionary is left out, and doesn't replace the default one
+		for _, m := range Omnimatch("flimbertokwow", nil, opts) {
+			assert.NotEqual(t, "flimbertok", m.Token, name)
+			if m.DictionaryName == "us_tv_and_film" && m.Token == "wow" {
+				assert.Equal(t, 322, m.Rank, name)
+			}
+		}
+	}
+
+	opts := testOptions
+	opts.Dictionaries = map[string]*RankedDictionary{"project_words": d}
+	assert.NoError(t, opts.Validate())
+	found := false
+	for _, m := range Omnimatch("flimbertokwow", nil, opts) {
+		found = found || m.DictionaryName == "project_words" && m.Token == "flimbertok"
+	}
+	assert.True(t, found)
+}
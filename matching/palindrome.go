@@ -0,0 +1,99 @@
+package matching
+
+import (
+	"sort"
+
+	"github.com/trustelem/zxcvbn/match"
+	"github.com/trustelem/zxcvbn/scoring"
+)
+
+// palindromeMatch matches mirrored tokens, whose second half reverses the first,
+// maybe around a pivot character: "abccba", "1234321" or "passssap". The first half is
+// matched and scored recursively, as repeatMatch does with the repeated token.
+type palindromeMatch struct {
+	opts Options
+}
+
+// minPalindromeHalf is the length of the half of the shortest palindromes matched.
+const minPalindromeHalf = 2
+
+func (pm palindromeMatch) Matches(password string) []*match.Match {
+	runes := []rune(password)
+	// offsets[k] is the byte index of runes[k]
+	offsets := make([]int, 0, len(runes)+1)
+	for i := range password {
+		offsets = append(offsets, i)
+	}
+	offsets = append(offsets, len(password))
+
+	palindromes := palindromeSpans(runes)
+
+	// the base tokens are analysed without palindromes, once per base token
+	opts := pm.opts
+	opts.Palindromes = false
+	bases := make(map[string]scoring.Result)
+
+	var matches []*match.Match
+	for _, p := range palindromes {
+		i, c, end, j := offsets[p.i], offsets[p.c], offsets[p.c+p.pivot], offsets[p.j+1]-1
+		baseToken := password[i:c]
+		base, ok := bases[baseToken]
+		if !ok {
+			base = opts.Scorer.MostGuessableMatchSequence(baseToken, Omnimatch(baseToken, nil, opts), false)
+			bases[baseToken] = base
+		}
+		matches = append(matches, &match.Match{
+			Pattern:     "palindrome",
+			I:           i,
+			J:           j,
+			Token:       password[i : j+1],
+			BaseToken:   baseToken,
+			BaseGuesses: base.Guesses,
+			BaseMatches: base.Sequence,
+			Pivot:       password[c:end],
+		})
+	}
+	match.Sort(matches)
+	return matches
+}
+
+// palindromeSpans returns the longest palindrome around each center of runes, between
+// runes[c-1] and runes[c] or around the pivot runes[c], sorted by start. Palindromes
+// inside a longer one are left out: in "aaaaaa", only the whole password is kept.
+func palindromeSpans(runes []rune) []palindromeSpan {
+	var spans []palindromeSpan
+	for c := 1; c < len(runes); c++ {
+		for _, pivot := range []int{0, 1} {
+			half := 0
+			for c-half-1 >= 0 && c+pivot+half < len(runes) && runes[c-half-1] == runes[c+pivot+half] {
+				half++
+			}
+			if half >= minPalindromeHalf {
+				spans = append(spans, palindromeSpan{i: c - half, c: c, pivot: pivot, j: c + pivot + half - 1})
+			}
+		}
+	}
+
+	// each span has its own center, so spans are distinct
+	sort.Slice(spans, func(a, b int) bool {
+		if spans[a].i != spans[b].i {
+			return spans[a].i < spans[b].i
+		}
+		return spans[a].j > spans[b].j
+	})
+	var outer []palindromeSpan
+	maxJ := -1
+	for _, p := range spans {
+		if p.j > maxJ {
+			outer = append(outer, p)
+			maxJ = p.j
+		}
+	}
+	return outer
+}
+
+// palindromeSpan is the longest palindrome around a center, from runes[i] to runes[j]:
+// its first half ends before runes[c], and it has a pivot if pivot is 1.
+type palindromeSpan struct {
+	i, c, pivot, j int
+}
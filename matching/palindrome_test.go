@@ -0,0 +1,77 @@
+package matching
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPalindromeMatching(t *testing.T) {
+	pm := palindromeMatch{opts: testOptions}
+	for _, tt := range []struct {
+		password  string
+		i, j      int
+		baseToken string
+		pivot     string
+	}{
+		{"abccba", 0, 5, "abc", ""},
+		{"1234321", 0, 6, "123", "4"},
+		{"xxpassssap!", 2, 9, "pass", ""},
+		{"ébccbé", 0, 7, "ébc", ""},
+		{"ébcXcbé", 0, 8, "ébc", "X"},
+	} {
+		found := false
+		for _, m := range pm.Matches(tt.password) {
+			if m.I == tt.i && m.J == tt.j {
+				found = true
+				assert.Equal(t, "palindrome", m.Pattern, tt.password)
+				assert.Equal(t, tt.password[tt.i:tt.j+1], m.Token, tt.password)
+				assert.Equal(t, tt.baseToken, m.BaseToken, tt.password)
+				assert.Equal(t, tt.pivot, m.Pivot, tt.password)
+				assert.NotEmpty(t, m.BaseMatches, tt.password)
+			}
+		}
+		assert.True(t, found, "palindrome (i=%d, j=%d) not found in %s", tt.i, tt.j, tt.password)
+	}
+
+	// halves of 1 character are left out
+	assert.Empty(t, pm.Matches("bb"))
+	assert.Empty(t, pm.Matches("aba"))
+	assert.Empty(t, pm.Matches("abcdef"))
+}
+
+func TestPalindromeMatchingRepetitive(t *testing.T) {
+	// palindromes inside a longer one are left out
+	pm := palindromeMatch{opts: testOptions}
+	matches := pm.Matches("yabacabax")
+	if assert.Len(t, matches, 1) {
+		assert.Equal(t, "abacaba", matches[0].Token)
+		assert.Equal(t, "c", matches[0].Pivot)
+	}
+
+	// a long run of the same character is one palindrome, so its base token is
+	// analysed once, and without palindromes
+	password := strings.Repeat("a", 256)
+	assert.Equal(t, []palindromeSpan{{i: 0, c: 128, pivot: 0, j: 255}}, palindromeSpans([]rune(password)))
+	opts := testOptions
+	opts.Palindromes = true
+	var palindromes []string
+	for _, m := range Omnimatch(password, nil, opts) {
+		if m.Pattern == "palindrome" {
+			palindromes = append(palindromes, m.Token)
+			for _, base := range m.BaseMatches {
+				assert.NotEqual(t, "palindrome", base.Pattern)
+			}
+		}
+	}
+	assert.Equal(t, []string{password}, palindromes)
+}
+
+func BenchmarkPalindromeMatch(b *testing.B) {
+	pm := palindromeMatch{opts: testOptions}
+	password := strings.Repeat("a", 256)
+	for n := 0; n < b.N; n++ {
+		pm.Matches(password)
+	}
+}
@@ -0,0 +1,182 @@
+package matching
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/trustelem/zxcvbn/frequency"
+	"github.com/trustelem/zxcvbn/internal/mathutils"
+	"github.com/trustelem/zxcvbn/match"
+	"github.com/trustelem/zxcvbn/scoring"
+)
+
+// passphraseMatch matches passphrases: dictionary words separated by one of
+// scoring.Separators, such as "correct-horse-battery-staple" or "alpha bravo
+// charlie delta". Words are looked up in the ranked dictionaries together, and in each
+// word list of passphrase generators.
+type passphraseMatch struct {
+	dm dictionaryMatch
+	// wordlists are the word lists of passphrase generators, whose words are picked
+	// uniformly.
+	wordlists map[string]rankedDictionnary
+}
+
+// minPassphraseWords is the number of words of the shortest passphrases matched.
+const minPassphraseWords = 3
+
+var defaultWordlists = loadDefaultWordlists()
+
+func loadDefaultWordlists() map[string]rankedDictionnary {
+	wordlists := make(map[string]rankedDictionnary, len(frequency.Wordlists))
+	for name, list := range frequency.Wordlists {
+		wordlists[name] = buildRankedDict(list)
+	}
+	return wordlists
+}
+
+func (pm passphraseMatch) Matches(password string) []*match.Match {
+	var matches []*match.Match
+	for _, sep := range scoring.Separators {
+		if !strings.ContainsRune(password, sep) {
+			continue
+		}
+		fields := splitFields(password, string(sep))
+		matches = append(matches, pm.matchFields(password, string(sep), fields, "", pm.dictionaryRank)...)
+		for _, name := range pm.wordlistNames() {
+			list := pm.wordlists[name]
+			wordlistRank := func(word string) (int, bool) {
+				// words of generators are picked uniformly
+				_, ok := list[word]
+				return len(list), ok
+			}
+			matches = append(matches, pm.matchFields(password, string(sep), fields, name, wordlistRank)...)
+		}
+	}
+	match.Sort(matches)
+	return matches
+}
+
+func (pm passphraseMatch) wordlistNames() []string {
+	names := make([]string, 0, len(pm.wordlists))
+	for name := range pm.wordlists {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// dictionaryRank returns the best rank of word in the ranked dictionaries.
+func (pm passphraseMatch) dictionaryRank(word string) (int, bool) {
+	best := 0
+	for _, d := range pm.dm.rankedDictionaries {
+		if rank, ok := d[word]; ok && (best == 0 || rank < best) {
+			best = rank
+		}
+	}
+	return best, best > 0
+}
+
+// dictionaryWords returns the number of words of the ranked dictionaries ranked up to rank.
+func (pm passphraseMatch) dictionaryWords(rank int) int {
+	n := 0
+	for _, d := range pm.dm.rankedDictionaries {
+		n += mathutils.Min(rank, len(d))
+	}
+	return n
+}
+
+// matchFields returns the runs of minPassphraseWords fields or more that are words,
+// according to rank. Words of the ranked dictionaries (name is empty) may come from
+// several dictionaries. The first word may be preceded and the last one followed by
+// other characters in their fields, such as "xcorrect-horse-battery1".
+func (pm passphraseMatch) matchFields(password, sep string, fields []field, name string, rank func(word string) (int, bool)) []*match.Match {
+	var matches []*match.Match
+	add := func(i, j int, words []string, maxRank int) {
+		m := &match.Match{
+			Pattern:        "passphrase",
+			I:              i,
+			J:              j,
+			Token:          password[i : j+1],
+			Words:          append([]string(nil), words...),
+			Separator:      sep,
+			DictionaryName: name,
+			Rank:           maxRank,
+		}
+		if name == "" {
+			m.Rank = pm.dictionaryWords(maxRank)
+		}
+		matches = append(matches, m)
+	}
+	for a := range fields {
+		i := fields[a].i
+		word, maxRank, ok := fieldWord(fields[a].token, rank)
+		if !ok {
+			var start int
+			start, word, maxRank, ok = affixedWord(fields[a].token, true, rank)
+			if !ok {
+				continue
+			}
+			i += start
+		}
+		words := append(make([]string, 0, len(fields)-a), word)
+		for b := a + 1; b < len(fields); b++ {
+			word, r, ok := fieldWord(fields[b].token, rank)
+			if !ok {
+				// a word followed by other characters ends the passphrase
+				end, word, r, ok := affixedWord(fields[b].token, false, rank)
+				if ok && len(words)+1 >= minPassphraseWords {
+					add(i, fields[b].i+end-1, append(words, word), mathutils.Max(maxRank, r))
+				}
+				break
+			}
+			words = append(words, word)
+			maxRank = mathutils.Max(maxRank, r)
+			if len(words) >= minPassphraseWords {
+				add(i, fields[b].j, words, maxRank)
+			}
+		}
+	}
+	return matches
+}
+
+// fieldWord reports whether the token of a field is a word, according to rank.
+func fieldWord(token string, rank func(word string) (int, bool)) (string, int, bool) {
+	if token == "" {
+		return "", 0, false
+	}
+	word := strings.ToLower(token)
+	r, ok := rank(word)
+	return word, r, ok
+}
+
+// minAffixedWordLength is the length in runes of the shortest words of passphrases
+// found inside a longer field.
+const minAffixedWordLength = 3
+
+// affixedWord returns the longest word, according to rank, that ends token if suffix
+// is true, or starts it otherwise, leaving other characters before or after it. It
+// returns the offset of the word in token if suffix is true, or of its end otherwise.
+func affixedWord(token string, suffix bool, rank func(word string) (int, bool)) (int, string, int, bool) {
+	// offsets[k] is the byte offset of the k-th rune of token
+	offsets := make([]int, 0, len(token)+1)
+	for offset := range token {
+		offsets = append(offsets, offset)
+	}
+	offsets = append(offsets, len(token))
+	n := len(offsets) - 1
+	// parts of n-k runes, longest first
+	for k := 1; n-k >= minAffixedWordLength; k++ {
+		start, end := offsets[k], len(token)
+		if !suffix {
+			start, end = 0, offsets[n-k]
+		}
+		word := strings.ToLower(token[start:end])
+		if r, ok := rank(word); ok {
+			if suffix {
+				return start, word, r, true
+			}
+			return end, word, r, true
+		}
+	}
+	return 0, "", 0, false
+}
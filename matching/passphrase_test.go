@@ -0,0 +1,92 @@
+package matching
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustelem/zxcvbn/match"
+)
+
+func TestPassphraseMatching(t *testing.T) {
+	pm := passphraseMatch{
+		dm: dictionaryMatch{
+			rankedDictionaries: map[string]rankedDictionnary{
+				"d1": buildRankedDict([]string{"correct", "horse", "x", "y"}),
+				"d2": buildRankedDict([]string{"battery", "staple"}),
+			},
+		},
+		wordlists: map[string]rankedDictionnary{
+			"generator": buildRankedDict([]string{"abacus", "horse", "zoology", "battery", "unwoven"}),
+		},
+	}
+
+	for _, tt := range []struct {
+		password string
+		want     []*match.Match
+	}{
+		{
+			// words are picked among the words ranked up to 2 in both dictionaries
+			password: "Correct-horse-battery",
+			want: []*match.Match{{
+				Pattern: "passphrase", I: 0, J: 20, Token: "Correct-horse-battery",
+				Words: []string{"correct", "horse", "battery"}, Separator: "-", Rank: 4,
+			}},
+		},
+		{
+			password: "abacus zoology horse",
+			want: []*match.Match{{
+				Pattern: "passphrase", I: 0, J: 19, Token: "abacus zoology horse",
+				Words: []string{"abacus", "zoology", "horse"}, Separator: " ",
+				DictionaryName: "generator", Rank: 5,
+			}},
+		},
+		{
+			password: "x.horse.battery.staple",
+			want: []*match.Match{
+				{
+					Pattern: "passphrase", I: 0, J: 14, Token: "x.horse.battery",
+					Words: []string{"x", "horse", "battery"}, Separator: ".", Rank: 5,
+				},
+				{
+					Pattern: "passphrase", I: 0, J: 21, Token: "x.horse.battery.staple",
+					Words: []string{"x", "horse", "battery", "staple"}, Separator: ".", Rank: 5,
+				},
+				{
+					Pattern: "passphrase", I: 2, J: 21, Token: "horse.battery.staple",
+					Words: []string{"horse", "battery", "staple"}, Separator: ".", Rank: 4,
+				},
+			},
+		},
+		{
+			// the outer words may have other characters attached
+			password: "xcorrect-horse-battery1",
+			want: []*match.Match{{
+				Pattern: "passphrase", I: 1, J: 21, Token: "correct-horse-battery",
+				Words: []string{"correct", "horse", "battery"}, Separator: "-", Rank: 4,
+			}},
+		},
+		{
+			password: "2abacus zoology horses",
+			want: []*match.Match{{
+				Pattern: "passphrase", I: 1, J: 20, Token: "abacus zoology horse",
+				Words: []string{"abacus", "zoology", "horse"}, Separator: " ",
+				DictionaryName: "generator", Rank: 5,
+			}},
+		},
+		// but not the inner ones, and words found inside a field have 3 letters or more
+		{password: "correct-horse1-battery"},
+		{password: "correct-horse-xy"},
+		// too few words, empty words and mixed separators
+		{password: "correct-horse"},
+		{password: "correct--horse-battery"},
+		{password: "correct-horse.battery"},
+	} {
+		assert.Equal(t, tt.want, pm.Matches(tt.password), tt.password)
+	}
+}
+
+func TestDefaultWordlists(t *testing.T) {
+	assert.Len(t, defaultWordlists["diceware"], 7776)
+	assert.Len(t, defaultWordlists["eff_large"], 7776)
+	assert.Len(t, defaultWordlists["eff_short"], 1296)
+}
@@ -0,0 +1,37 @@
+package matching
+
+import (
+	"github.com/trustelem/zxcvbn/adjacency"
+	"github.com/trustelem/zxcvbn/frequency"
+	"github.com/trustelem/zxcvbn/match"
+)
+
+// pinDictionaryName is the name of the dictionary of common PINs.
+const pinDictionaryName = "pins"
+
+var (
+	pinDictionary = dictionaryMatch{rankedDictionaries: map[string]rankedDictionnary{
+		pinDictionaryName: buildRankedDict(frequency.PINs),
+	}}
+	keypadGraphs = []*adjacency.Graph{
+		adjacency.Graphs["keypad"],
+		adjacency.Graphs["mac_keypad"],
+	}
+)
+
+// pinMatchers returns the matchers of Options.PIN: common PINs, keypad patterns,
+// repeats, sequences, years and dates, and words typed on a phone keypad with Options.T9.
+func (o Options) pinMatchers() []match.Matcher {
+	matchers := []match.Matcher{
+		digitNormalizedMatch{pinDictionary},
+		digitNormalizedMatch{spatialMatch{graphs: keypadGraphs}},
+		repeatMatch{opts: o},
+		digitNormalizedMatch{sequenceMatch{}},
+		digitNormalizedMatch{o.recentYearMatch()},
+		digitNormalizedMatch{o.dateMatch()},
+	}
+	if o.T9 {
+		matchers = append(matchers, digitNormalizedMatch{t9Match{dm: defaultRankedDictionnaries}})
+	}
+	return matchers
+}
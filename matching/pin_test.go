@@ -0,0 +1,52 @@
+package matching
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPINOmnimatch(t *testing.T) {
+	opts := testOptions
+	opts.PIN = true
+	for _, tt := range []struct {
+		pin     string
+		pattern string
+		i, j    int
+	}{
+		{"1234", "dictionary", 0, 3},
+		{"7412", "spatial", 0, 3},
+		{"13571357", "repeat", 0, 7},
+		{"9876", "sequence", 0, 3},
+		{"1987", "regex", 0, 3},
+		{"12121985", "date", 0, 7},
+	} {
+		found := false
+		for _, m := range Omnimatch(tt.pin, nil, opts) {
+			if m.I == tt.i && m.J == tt.j && m.Pattern == tt.pattern {
+				found = true
+				if m.Pattern == "dictionary" {
+					assert.Equal(t, pinDictionaryName, m.DictionaryName)
+				}
+				if m.Pattern == "spatial" {
+					assert.Contains(t, []string{"keypad", "mac_keypad"}, m.Graph)
+				}
+			}
+		}
+		assert.True(t, found, "%s: pattern %s (i=%d, j=%d) not found", tt.pin, tt.pattern, tt.i, tt.j)
+	}
+
+	// password dictionaries, user inputs and QWERTY patterns are left out
+	for _, m := range Omnimatch("1qaz2wsx", []string{"1qaz"}, opts) {
+		assert.NotEqual(t, "spatial", m.Pattern)
+		assert.NotEqual(t, "dictionary", m.Pattern)
+	}
+
+	// words typed on a phone keypad
+	opts.T9 = true
+	found := false
+	for _, m := range Omnimatch("5683", nil, opts) {
+		found = found || m.Pattern == "t9" && m.MatchedWord == "love"
+	}
+	assert.True(t, found)
+}
@@ -1,15 +1,22 @@
 package matching
 
 import (
-	"github.com/trustelem/zxcvbn/match"
+	"fmt"
 	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/trustelem/zxcvbn/internal/mathutils"
+	"github.com/trustelem/zxcvbn/match"
 )
 
+type namedRegexp struct {
+	Name   string
+	Regexp *regexp.Regexp
+}
+
 type regexpMatch struct {
-	regexes []struct {
-		Name   string
-		Regexp *regexp.Regexp
-	}
+	regexes []namedRegexp
 }
 
 func (r regexpMatch) Matches(password string) []*match.Match {
@@ -29,3 +36,70 @@ func (r regexpMatch) Matches(password string) []*match.Match {
 	match.Sort(matches)
 	return matches
 }
+
+// YearWindow is a range of years around a reference year.
+type YearWindow struct {
+	Before int
+	After  int
+}
+
+// defaultRecentYearWindow is used when Options.RecentYearWindow is nil. It matches
+// the upstream recent_year regex, 19\d\d|200\d|201\d, for the reference year 2019.
+var defaultRecentYearWindow = YearWindow{Before: 119, After: 0}
+
+// recentYearRegexps caches the recent_year regexps by [first, last] years: the
+// reference year only changes once a year. The cache is emptied when it holds
+// maxRecentYearRegexps regexps, so that arbitrary windows don't grow it forever.
+var (
+	recentYearRegexpsMu sync.Mutex
+	recentYearRegexps   = make(map[[2]int]*regexp.Regexp)
+)
+
+const maxRecentYearRegexps = 16
+
+// recentYearRegexp returns a regexp matching the 4-digit years within w around referenceYear.
+func recentYearRegexp(referenceYear int, w YearWindow) *regexp.Regexp {
+	first := mathutils.Max(referenceYear-w.Before, 1000)
+	last := mathutils.Min(referenceYear+w.After, 9999)
+	key := [2]int{first, last}
+	recentYearRegexpsMu.Lock()
+	defer recentYearRegexpsMu.Unlock()
+	if rx, ok := recentYearRegexps[key]; ok {
+		return rx
+	}
+	if len(recentYearRegexps) >= maxRecentYearRegexps {
+		recentYearRegexps = make(map[[2]int]*regexp.Regexp)
+	}
+	rx := regexp.MustCompile(yearRangePattern(first, last))
+	recentYearRegexps[key] = rx
+	return rx
+}
+
+// yearRangePattern returns an alternation matching the 4-digit numbers from first to last,
+// eg 189[8-9]|19\d\d|200\d|201[0-7] for 1898-2017.
+func yearRangePattern(first, last int) string {
+	if first > last {
+		// matches nothing
+		return `[^\x00-\x{10FFFF}]`
+	}
+	var alternatives []string
+	for y := first; y <= last; {
+		switch {
+		case y%100 == 0 && y+99 <= last:
+			alternatives = append(alternatives, fmt.Sprintf(`%02d\d\d`, y/100))
+			y += 100
+		case y%10 == 0 && y+9 <= last:
+			alternatives = append(alternatives, fmt.Sprintf(`%03d\d`, y/10))
+			y += 10
+		default:
+			end := mathutils.Min(y/10*10+9, last)
+			if end == y {
+				alternatives = append(alternatives, fmt.Sprintf(`%04d`, y))
+			} else {
+				alternatives = append(alternatives, fmt.Sprintf(`%03d[%d-%d]`, y/10, y%10, end%10))
+			}
+			y = end + 1
+		}
+	}
+	return strings.Join(alternatives, "|")
+}
@@ -3,11 +3,14 @@ package matching
 import (
 	"github.com/stretchr/testify/assert"
 	"github.com/trustelem/zxcvbn/match"
+	"github.com/trustelem/zxcvbn/scoring"
 	"testing"
 )
 
 func TestRegexpMatching(t *testing.T) {
-	rm := regexpMatch{regexes: defaultRegexpMatch}
+	rm := regexpMatch{regexes: []namedRegexp{
+		{Name: "recent_year", Regexp: testOptions.recentYearRegexp()},
+	}}
 	assert.Equal(t, []*match.Match{
 		{
 			Pattern:   "regex",
@@ -32,3 +35,65 @@ func TestRegexpMatching(t *testing.T) {
 		rm.Matches("2017"),
 	)
 }
+
+func TestRecentYearWindow(t *testing.T) {
+	recentYears := func(opts Options, password string) []string {
+		rm := regexpMatch{regexes: []namedRegexp{
+			{Name: "recent_year", Regexp: opts.recentYearRegexp()},
+		}}
+		var years []string
+		for _, m := range rm.Matches(password) {
+			years = append(years, m.Token)
+		}
+		return years
+	}
+
+	opts := Options{Scorer: scoring.Scorer{ReferenceYear: 2026}}
+	// years up to the reference year are recent years
+	assert.Equal(t, []string{"2023"}, recentYears(opts, "pass2023"))
+	assert.Equal(t, []string{"2026"}, recentYears(opts, "2026!"))
+	assert.Equal(t, []string{"1987"}, recentYears(opts, "1987x2029"))
+	// outside of the default window
+	assert.Empty(t, recentYears(opts, "1906"))
+	assert.Empty(t, recentYears(opts, "2027"))
+	// the default window is upstream's 19\d\d|200\d|201\d in 2019
+	upstream := Options{Scorer: scoring.Scorer{ReferenceYear: 2019}}
+	assert.Equal(t, []string{"1900", "2019"}, recentYears(upstream, "1899-1900-2019-2020"))
+	assert.Empty(t, recentYears(upstream, "2025"))
+	// leftmost non-overlapping matches, like the upstream regex
+	assert.Equal(t, []string{"2019"}, recentYears(opts, "12019"))
+
+	// a window beyond 4-digit years matches nothing
+	assert.Empty(t, recentYears(Options{Scorer: scoring.Scorer{ReferenceYear: 12000}}, "2026"))
+
+	// the window is configurable
+	opts.RecentYearWindow = &YearWindow{Before: 10, After: 20}
+	assert.Empty(t, recentYears(opts, "1987"))
+	assert.Equal(t, []string{"2042"}, recentYears(opts, "2042"))
+	// an empty window only matches the reference year
+	opts.RecentYearWindow = &YearWindow{}
+	assert.Equal(t, []string{"2026"}, recentYears(opts, "2025-2026-2027"))
+
+	// the cache of regexps is bounded
+	for y := 1000; y < 1100; y++ {
+		recentYearRegexp(y, YearWindow{})
+	}
+	assert.LessOrEqual(t, len(recentYearRegexps), maxRecentYearRegexps)
+	assert.Equal(t, []string{"1987"}, recentYears(Options{Scorer: scoring.Scorer{ReferenceYear: 2026}}, "1987"))
+}
+
+func Test_yearRangePattern(t *testing.T) {
+	tests := []struct {
+		first int
+		last  int
+		want  string
+	}{
+		{1900, 2019, `19\d\d|200\d|201\d`},
+		{1898, 2017, `189[8-9]|19\d\d|200\d|201[0-7]`},
+		{2026, 2026, `2026`},
+		{2019, 2021, `2019|202[0-1]`},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, yearRangePattern(tt.first, tt.last))
+	}
+}
@@ -1,85 +1,93 @@
 package matching
 
 import (
-	"github.com/dlclark/regexp2"
 	"github.com/trustelem/zxcvbn/match"
-	"github.com/trustelem/zxcvbn/scoring"
 )
 
-type repeatMatch struct{}
-
-var greedy = regexp2.MustCompile(`(.+)\1+`, 0)
-var lazy = regexp2.MustCompile(`(.+?)\1+`, 0)
-var lazyAnchored = regexp2.MustCompile(`^(.+?)\1+$`, 0)
-
-func runeToStringIndex(index int, password string) int {
-	runes := 0
-	for i := range password {
-		if runes == index {
-			return i
-		}
-		runes++
-	}
-	//shouldn't really get here
-	return len(password)
+// repeatMatch matches repeated tokens: "aaaaa", "abcabcabc" or "aabaab". From the start
+// of the password, the leftmost repeat is taken with its longest base token and with
+// its shortest one, and the longer of both repeats wins: "aabaab" is a repeat of "aab"
+// rather than "aa" followed by "baab". The base token is then shortened as much as
+// possible: "abababab" is a repeat of "ab", not "abab".
+//
+// Repeats are found from the squares of the password, see squarePeriods, without
+// backtracking. Like the regular expressions of the reference implementation, base
+// tokens don't span line breaks.
+type repeatMatch struct {
+	opts Options
 }
 
-func (repeatMatch) Matches(password string) []*match.Match {
+func (rm repeatMatch) Matches(password string) []*match.Match {
 	var matches []*match.Match
+	runes := []rune(password)
+	// offsets[k] is the byte index of runes[k]
+	offsets := make([]int, 0, len(runes)+1)
+	for i := range password {
+		offsets = append(offsets, i)
+	}
+	offsets = append(offsets, len(password))
 
-	lastIndex := 0
-	for lastIndex < len(password) {
-		greedyMatch, err := greedy.FindStringMatchStartingAt(password, lastIndex)
-		if err != nil || greedyMatch == nil {
-			break
+	shortest, longest := squarePeriods(runes)
+	for i := 0; i < len(runes); i++ {
+		if shortest[i] == 0 {
+			continue
 		}
-		lazyMatch, _ := lazy.FindStringMatchStartingAt(password, lastIndex)
-
-		var rmatch *regexp2.Match
-		var baseToken string
-		if greedyMatch.Captures[0].Length > lazyMatch.Captures[0].Length {
-			// greedy beats lazy for 'aabaab'
-			//   greedy: [aabaab, aab]
-			//   lazy:   [aa,     a]
-			rmatch = greedyMatch
-			// greedy's repeated string might itself be repeated, eg.
-			// aabaab in aabaabaabaab.
-			// run an anchored lazy match on greedy's repeated string
-			// to find the shortest repeated string
-			if m, err := lazyAnchored.FindStringMatch(rmatch.Captures[0].String()); err == nil {
-				baseToken = m.GroupByNumber(1).String()
-			}
-		} else {
-			// lazy beats greedy for 'aaaaa'
-			//   greedy: [aaaa,  aa]
-			//   lazy:   [aaaaa, a]
-			rmatch = lazyMatch
-			baseToken = rmatch.GroupByNumber(1).String()
+		// greedy beats lazy for 'aabaab', lazy beats greedy for 'aaaaa'
+		length, period := repeatLength(runes, i, shortest[i]), shortest[i]
+		if greedy := repeatLength(runes, i, longest[i]); greedy > length {
+			// greedy's repeated string might itself be repeated, eg. aabaab in
+			// aabaabaabaab: use its shortest period
+			length, period = greedy, smallestPeriod(runes[i:i+greedy])
 		}
-		// FindStringMatchStartingAt takes an index into the string (basically an offset
-		// into a byte array). rmatch indices will be rune offsets and so need to be converted
-		// to string offsets
-		i := runeToStringIndex(rmatch.Index, password)
-		j := runeToStringIndex(rmatch.Index+rmatch.Captures[0].Length-1, password)
+		j := i + length
+		baseToken := string(runes[i : i+period])
 
 		// recursively match and score the base string
-		baseAnalysis := scoring.MostGuessableMatchSequence(
+		baseAnalysis := rm.opts.Scorer.MostGuessableMatchSequence(
 			baseToken,
-			Omnimatch(baseToken, nil),
+			Omnimatch(baseToken, nil, rm.opts),
 			false,
 		)
 		matches = append(matches, &match.Match{
 			Pattern:     "repeat",
-			I:           i,
-			J:           j,
-			Token:       rmatch.Captures[0].String(),
+			I:           offsets[i],
+			J:           offsets[j] - 1,
+			Token:       password[offsets[i]:offsets[j]],
 			BaseToken:   baseToken,
 			BaseGuesses: baseAnalysis.Guesses,
 			BaseMatches: baseAnalysis.Sequence,
-			RepeatCount: rmatch.Captures[0].Length / len(baseToken),
+			RepeatCount: length / period,
 		})
-		lastIndex = j + 1
-
+		i = j - 1
 	}
 	return matches
 }
+
+// repeatLength returns the length of the longest repeat of s[i:i+period] from i.
+func repeatLength(s []rune, i, period int) int {
+	k := i + period
+	for k < len(s) && s[k] == s[k-period] {
+		k++
+	}
+	return (k - i) / period * period
+}
+
+// smallestPeriod returns the length of the shortest token that s repeats, computed
+// with the prefix function of s.
+func smallestPeriod(s []rune) int {
+	prefix := make([]int, len(s))
+	for k := 1; k < len(s); k++ {
+		p := prefix[k-1]
+		for p > 0 && s[k] != s[p] {
+			p = prefix[p-1]
+		}
+		if s[k] == s[p] {
+			p++
+		}
+		prefix[k] = p
+	}
+	if period := len(s) - prefix[len(s)-1]; len(s)%period == 0 {
+		return period
+	}
+	return len(s)
+}
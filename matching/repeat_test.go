@@ -18,7 +18,7 @@ func removeRepeatBaseData(matches []*match.Match) []*match.Match {
 }
 
 func TestRepeatMatching(t *testing.T) {
-	r := repeatMatch{}
+	r := repeatMatch{opts: testOptions}
 
 	// doesn't match 0- and 1-character repeat patterns
 	assert.Empty(t, r.Matches(""))
@@ -171,13 +171,76 @@ func TestRepeatMatching(t *testing.T) {
 	}, matches)
 }
 
+func TestRepeatMatchingUnicode(t *testing.T) {
+	r := repeatMatch{opts: testOptions}
+
+	// offsets are byte indexes, repeat counts are counted in characters
+	matches := removeRepeatBaseData(r.Matches("xéééabcabc"))
+	assert.Equal(t, []*match.Match{
+		{
+			Pattern:     "repeat",
+			Token:       "ééé",
+			I:           1,
+			J:           6,
+			BaseToken:   "é",
+			RepeatCount: 3,
+		},
+		{
+			Pattern:     "repeat",
+			Token:       "abcabc",
+			I:           7,
+			J:           12,
+			BaseToken:   "abc",
+			RepeatCount: 2,
+		},
+	}, matches)
+
+	// repeats don't span line breaks
+	matches = removeRepeatBaseData(r.Matches("a\na\n"))
+	assert.Empty(t, matches)
+	matches = removeRepeatBaseData(r.Matches("ab\nab\nxx"))
+	assert.Equal(t, []*match.Match{
+		{
+			Pattern:     "repeat",
+			Token:       "xx",
+			I:           6,
+			J:           7,
+			BaseToken:   "x",
+			RepeatCount: 2,
+		},
+	}, matches)
+}
+
+func TestRepeatMatchingLongPassword(t *testing.T) {
+	r := repeatMatch{opts: testOptions}
+
+	// long passwords without repeats are matched quickly
+	var b strings.Builder
+	for k := 0; k < 5000; k++ {
+		b.WriteRune(rune(0x4e00 + k))
+	}
+	assert.Empty(t, r.Matches(b.String()+"x"))
+
+	matches := removeRepeatBaseData(r.Matches(strings.Repeat("ab", 2500)))
+	assert.Equal(t, []*match.Match{
+		{
+			Pattern:     "repeat",
+			Token:       strings.Repeat("ab", 2500),
+			I:           0,
+			J:           4999,
+			BaseToken:   "ab",
+			RepeatCount: 2500,
+		},
+	}, matches)
+}
+
 func TestCornerCases(t *testing.T) {
 	// cases found in fuzzing
 	testCases := []string{
 		"�\u007f\x00\x00Q",
 	}
 
-	r := repeatMatch{}
+	r := repeatMatch{opts: testOptions}
 
 	for _, password := range testCases {
 		_ = r.Matches(password)
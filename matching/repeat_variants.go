@@ -0,0 +1,179 @@
+package matching
+
+import (
+	"sort"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/trustelem/zxcvbn/match"
+)
+
+// variantRepeatMatch matches repeats whose repetitions differ from the base token by
+// case, single character l33t substitutions or reversal: "abcABC", "passP4SS" or
+// "dog!DOG!". Repeats of identical tokens are left to repeatMatch.
+//
+// Like repeatMatch, candidates are found from the squares of the password, see
+// squarePeriods, once case and l33t substitutions are ignored.
+type variantRepeatMatch struct {
+	opts Options
+	// letters maps the l33t characters of the table to the letters they stand for.
+	letters map[rune][]rune
+}
+
+func newVariantRepeatMatch(opts Options) variantRepeatMatch {
+	table := opts.L33tTable
+	if table == nil {
+		table = DefaultL33tTable
+	}
+	letters := make(map[rune][]rune)
+	for letter, subs := range table.subs {
+		l, _ := utf8.DecodeRuneInString(letter)
+		for _, sub := range subs {
+			if r, size := utf8.DecodeRuneInString(sub); size == len(sub) {
+				letters[r] = append(letters[r], l)
+			}
+		}
+	}
+	for _, l := range letters {
+		sort.Slice(l, func(a, b int) bool { return l[a] < l[b] })
+	}
+	return variantRepeatMatch{opts: opts, letters: letters}
+}
+
+// minVariantRepeatBase is the number of distinct characters, regardless of case and
+// l33t substitutions, of the shortest base tokens of variant repeats: alternating cases
+// of one character, such as "aA" or "aAaAaA", are left to the repeat and bruteforce
+// matches.
+const minVariantRepeatBase = 2
+
+func (vm variantRepeatMatch) Matches(password string) []*match.Match {
+	var matches []*match.Match
+	runes := []rune(password)
+	// offsets[k] is the byte index of runes[k]
+	offsets := make([]int, 0, len(runes)+1)
+	for i := range password {
+		offsets = append(offsets, i)
+	}
+	offsets = append(offsets, len(password))
+
+	// variant repeats are squares, or even palindromes when reversed, of the password
+	// without case and l33t substitutions: those give the candidate base tokens
+	canonical := make([]rune, len(runes))
+	for k, r := range runes {
+		canonical[k] = vm.canonical(r)
+	}
+	shortest, longest := squarePeriods(canonical)
+	reversed := make([]int, len(runes))
+	for _, p := range palindromeSpans(canonical) {
+		if p.pivot == 0 {
+			reversed[p.i] = p.c - p.i
+		}
+	}
+
+	for i := 0; i < len(runes); {
+		// the longest repeat from i, with the shortest base token
+		var best []match.RepeatVariant
+		bestLength := 0
+		for _, length := range []int{shortest[i], longest[i], reversed[i]} {
+			if length < minVariantRepeatBase || smallestPeriod(canonical[i:i+length]) < minVariantRepeatBase {
+				continue
+			}
+			variants, varied := vm.repetitions(runes, i, length)
+			if varied && (len(variants)+1)*length > (len(best)+1)*bestLength {
+				best, bestLength = variants, length
+			}
+		}
+		if best == nil {
+			i++
+			continue
+		}
+		j := i + (len(best)+1)*bestLength
+		baseToken := string(runes[i : i+bestLength])
+		baseAnalysis := vm.opts.Scorer.MostGuessableMatchSequence(
+			baseToken,
+			Omnimatch(baseToken, nil, vm.opts),
+			false,
+		)
+		matches = append(matches, &match.Match{
+			Pattern:        "repeat",
+			I:              offsets[i],
+			J:              offsets[j] - 1,
+			Token:          password[offsets[i]:offsets[j]],
+			BaseToken:      baseToken,
+			BaseGuesses:    baseAnalysis.Guesses,
+			BaseMatches:    baseAnalysis.Sequence,
+			RepeatCount:    len(best) + 1,
+			RepeatVariants: best,
+		})
+		i = j
+	}
+	return matches
+}
+
+// repetitions returns the variants of runes[i:i+length] repeated right after it, and
+// whether one of them differs from it.
+func (vm variantRepeatMatch) repetitions(runes []rune, i, length int) (variants []match.RepeatVariant, varied bool) {
+	base := runes[i : i+length]
+	for k := i + length; k+length <= len(runes); k += length {
+		v, ok := vm.variant(base, runes[k:k+length])
+		if !ok {
+			break
+		}
+		v.Token = string(runes[k : k+length])
+		varied = varied || v.Case || v.L33tSubs > 0 || v.Reversed
+		variants = append(variants, v)
+	}
+	return variants, varied
+}
+
+// canonical returns r in lower case, or the first letter it stands for if it is a
+// l33t character: l33t characters standing for several letters are only found as
+// the first one.
+func (vm variantRepeatMatch) canonical(r rune) rune {
+	if letters := vm.letters[r]; len(letters) > 0 {
+		return letters[0]
+	}
+	return unicode.ToLower(r)
+}
+
+// variant reports whether repetition is base, maybe with another case, with l33t
+// substitutions or reversed, and how.
+func (vm variantRepeatMatch) variant(base, repetition []rune) (match.RepeatVariant, bool) {
+	if v, ok := vm.compare(base, repetition, false); ok {
+		return v, true
+	}
+	return vm.compare(base, repetition, true)
+}
+
+func (vm variantRepeatMatch) compare(base, repetition []rune, reversed bool) (match.RepeatVariant, bool) {
+	v := match.RepeatVariant{Reversed: reversed}
+	for k, b := range base {
+		r := repetition[k]
+		if reversed {
+			r = repetition[len(repetition)-1-k]
+		}
+		switch {
+		case r == b:
+		case unicode.ToLower(r) == unicode.ToLower(b):
+			v.Case = true
+		case vm.isL33t(r, b) || vm.isL33t(b, r):
+			v.L33tSubs++
+			if unicode.IsUpper(r) || unicode.IsUpper(b) {
+				v.Case = true
+			}
+		default:
+			return v, false
+		}
+	}
+	return v, true
+}
+
+// isL33t reports whether sub is a l33t substitution for letter, in any case.
+func (vm variantRepeatMatch) isL33t(sub, letter rune) bool {
+	for _, l := range vm.letters[sub] {
+		if l == unicode.ToLower(letter) {
+			return true
+		}
+	}
+	return false
+}
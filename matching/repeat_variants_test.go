@@ -0,0 +1,60 @@
+package matching
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/trustelem/zxcvbn/match"
+)
+
+func TestVariantRepeatMatching(t *testing.T) {
+	vm := newVariantRepeatMatch(testOptions)
+	for _, tt := range []struct {
+		password  string
+		i, j      int
+		baseToken string
+		variants  []match.RepeatVariant
+	}{
+		{"abcABC", 0, 5, "abc", []match.RepeatVariant{{Token: "ABC", Case: true}}},
+		{"passP4SS", 0, 7, "pass", []match.RepeatVariant{{Token: "P4SS", Case: true, L33tSubs: 1}}},
+		{"dog!DOG!", 0, 7, "dog!", []match.RepeatVariant{{Token: "DOG!", Case: true}}},
+		{"xxdogg0d", 2, 7, "dog", []match.RepeatVariant{{Token: "g0d", L33tSubs: 1, Reversed: true}}},
+		{"abcabcABC", 0, 8, "abc", []match.RepeatVariant{{Token: "abc"}, {Token: "ABC", Case: true}}},
+		{"éteÉTE", 0, 7, "éte", []match.RepeatVariant{{Token: "ÉTE", Case: true}}},
+	} {
+		found := false
+		for _, m := range vm.Matches(tt.password) {
+			if m.I == tt.i && m.J == tt.j {
+				found = true
+				assert.Equal(t, "repeat", m.Pattern, tt.password)
+				assert.Equal(t, tt.password[tt.i:tt.j+1], m.Token, tt.password)
+				assert.Equal(t, tt.baseToken, m.BaseToken, tt.password)
+				assert.Equal(t, len(tt.variants)+1, m.RepeatCount, tt.password)
+				assert.Equal(t, tt.variants, m.RepeatVariants, tt.password)
+				assert.NotEmpty(t, m.BaseMatches, tt.password)
+			}
+		}
+		assert.True(t, found, "variant repeat (i=%d, j=%d) not found in %s", tt.i, tt.j, tt.password)
+	}
+
+	// repeats of identical tokens are left to the repeat matcher
+	assert.Empty(t, vm.Matches("abcabc"))
+	assert.Empty(t, vm.Matches("aaaa"))
+	assert.Empty(t, vm.Matches("abcxyz"))
+
+	// so are alternating cases of one character
+	assert.Empty(t, vm.Matches("aA"))
+	assert.Empty(t, vm.Matches("aAaAaA"))
+	assert.Empty(t, vm.Matches("4AaA"))
+}
+
+func BenchmarkVariantRepeatMatch(b *testing.B) {
+	vm := newVariantRepeatMatch(testOptions)
+	// repeats of identical tokens are the longest to rule out
+	password := strings.Repeat("ab", 128)
+	for n := 0; n < b.N; n++ {
+		vm.Matches(password)
+	}
+}
@@ -0,0 +1,94 @@
+package matching
+
+import (
+	"math"
+	"strings"
+
+	"github.com/trustelem/zxcvbn/internal/mathutils"
+	"github.com/trustelem/zxcvbn/match"
+	"github.com/trustelem/zxcvbn/scoring"
+)
+
+// separatedMatch matches tokens joined by a repeated separator, one of
+// scoring.Separators, such as "john_smith_1985": each token is matched and scored on
+// its own, so that the separator is paid for once instead of as bruteforce.
+type separatedMatch struct {
+	opts       Options
+	userInputs []string
+}
+
+// minSeparatedTokens is the number of tokens of the shortest separated matches: the
+// separator must be repeated.
+const minSeparatedTokens = 3
+
+// field is a token of a password split by a separator, at password[i:j+1].
+type field struct {
+	i, j  int
+	token string
+}
+
+// splitFields splits password around each sep.
+func splitFields(password, sep string) []field {
+	var fields []field
+	i := 0
+	for _, token := range strings.Split(password, sep) {
+		fields = append(fields, field{i: i, j: i + len(token) - 1, token: token})
+		i += len(token) + len(sep)
+	}
+	return fields
+}
+
+func (sm separatedMatch) Matches(password string) []*match.Match {
+	var matches []*match.Match
+	for _, sep := range scoring.Separators {
+		if strings.Count(password, string(sep)) < minSeparatedTokens-1 {
+			continue
+		}
+		// runs of non-empty tokens
+		var run []field
+		for _, f := range append(splitFields(password, string(sep)), field{}) {
+			if f.token != "" {
+				run = append(run, f)
+				continue
+			}
+			if len(run) >= minSeparatedTokens {
+				matches = append(matches, sm.separated(password, string(sep), run))
+			}
+			run = run[:0]
+		}
+	}
+	match.Sort(matches)
+	return matches
+}
+
+// separated returns the match of the tokens of run, each scored on its own. Like
+// the matches of a sequence, each token takes at least scoring.MinSubmatchGuesses
+// and the tokens pay for their number as scoring.MostGuessableMatchSequence does.
+func (sm separatedMatch) separated(password, sep string, run []field) *match.Match {
+	m := &match.Match{
+		Pattern:   "separated",
+		I:         run[0].i,
+		J:         run[len(run)-1].j,
+		Token:     password[run[0].i : run[len(run)-1].j+1],
+		Separator: sep,
+	}
+	guesses := float64(1)
+	for _, f := range run {
+		analysis := sm.opts.Scorer.MostGuessableMatchSequence(
+			f.token,
+			Omnimatch(f.token, sm.userInputs, sm.opts),
+			false,
+		)
+		guesses *= math.Max(analysis.Guesses, scoring.MinSubmatchGuesses(f.token))
+		// base matches are moved from their token to the password
+		for _, bm := range analysis.Sequence {
+			bm.I += f.i
+			bm.J += f.i
+			m.BaseMatches = append(m.BaseMatches, bm)
+		}
+	}
+	l := len(run)
+	m.BaseGuesses = mathutils.Factorial(l)*guesses +
+		math.Pow(scoring.MinGuessesBeforeGrowingSequence, float64(l-1))
+	return m
+}
@@ -0,0 +1,64 @@
+package matching
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustelem/zxcvbn/scoring"
+)
+
+func Test_splitFields(t *testing.T) {
+	assert.Equal(t, []field{
+		{i: 0, j: 3, token: "john"},
+		{i: 5, j: 4, token: ""},
+		{i: 6, j: 10, token: "smith"},
+	}, splitFields("john__smith", "_"))
+}
+
+func TestSeparatedMatching(t *testing.T) {
+	sm := separatedMatch{opts: testOptions}
+
+	matches := sm.Matches("john_smith_1985")
+	if assert.Len(t, matches, 1) {
+		m := matches[0]
+		assert.Equal(t, "separated", m.Pattern)
+		assert.Equal(t, "_", m.Separator)
+		assert.Equal(t, 0, m.I)
+		assert.Equal(t, 14, m.J)
+		// base matches are at their place in the password
+		var tokens []string
+		guesses := float64(1)
+		for _, bm := range m.BaseMatches {
+			assert.Equal(t, bm.Token, m.Token[bm.I:bm.J+1])
+			tokens = append(tokens, bm.Token)
+			guesses *= bm.Guesses
+		}
+		assert.Equal(t, []string{"john", "smith", "1985"}, tokens)
+		// each token is scored as a sequence of its matches
+		assert.GreaterOrEqual(t, m.BaseGuesses, guesses)
+	}
+
+	// runs of 3 tokens or more, without empty tokens
+	matches = sm.Matches("x..abc.def.ghi")
+	if assert.Len(t, matches, 1) {
+		assert.Equal(t, "abc.def.ghi", matches[0].Token)
+		assert.Equal(t, 3, matches[0].I)
+	}
+	assert.Empty(t, sm.Matches("john_smith"))
+
+	// each token takes at least the guesses of a submatch, and their number is paid for
+	matches = sm.Matches("the.the.the")
+	if assert.Len(t, matches, 1) {
+		assert.Equal(t, 6*math.Pow(scoring.MinSubmatchGuessesMultiChar, 3)+
+			math.Pow(scoring.MinGuessesBeforeGrowingSequence, 2), matches[0].BaseGuesses)
+	}
+	assert.Empty(t, sm.Matches("a..b..c"))
+
+	// tokens are matched with the user inputs
+	sm.userInputs = []string{"trustelem"}
+	matches = sm.Matches("trustelem-is-great")
+	if assert.Len(t, matches, 1) {
+		assert.Equal(t, "user_inputs", matches[0].BaseMatches[0].DictionaryName)
+	}
+}
@@ -35,75 +35,73 @@ func stringToSet(s string) map[byte]bool {
 }
 
 func spatialMatchHelper(password string, graph *adjacency.Graph) (matches []*match.Match) {
-	shifted := shiftedChars[graph.Name]
-
 	i := 0
 	for i < len(password)-1 {
-		j := i + 1
-		lastDirection := -99
-		turns := 0
-		shiftedCount := 0
-		if shifted[password[i]] {
-			shiftedCount = 1
+		j, turns, shiftedCount := spatialWalk(password, i, graph, 0)
+		if j-i > 2 {
+			// don't consider length 1 or 2 chains.
+			matches = append(matches, &match.Match{
+				Pattern:      "spatial",
+				I:            i,
+				J:            j - 1,
+				Token:        password[i:j],
+				Graph:        graph.Name,
+				Turns:        turns,
+				ShiftedCount: shiftedCount,
+			})
 		}
+		// . . . and then start a new search from the rest of the password
+		i = j
+	}
+	return matches
+}
 
-		for {
-			prevChar := password[j-1]
-			found := false
-			foundDirection := -1
-			curDirection := -1
-			adjacents := graph.Graph[string(prevChar)]
-			// Consider growing pattern by one character if j hasn't gone over the edge
-			if j < len(password) {
-				curChar := password[j]
-				for _, adj := range adjacents {
-					curDirection++
-
-					if idx := strings.Index(adj, string(curChar)); idx != -1 {
-						found = true
-						foundDirection = curDirection
-
-						if idx == 1 {
-							// index 1 in the adjacency means the key is shifted, 0 means unshifted: A vs a, % vs 5, etc.
-							// for example, 'q' is adjacent to the entry '2@'. @ is shifted w/ index 1, 2 is unshifted.
-							shiftedCount++
-						}
-
-						if lastDirection != foundDirection {
-							// adding a turn is correct even in the initial case when last_direction is null:
-							// every spatial pattern starts with a turn.
-							turns++
-							lastDirection = foundDirection
-						}
-						break
-					}
-				}
-			}
+// spatialWalk returns the end j of the walk on graph starting at password[i], with
+// its turns and shifted keys. Each step of the walk skips gap keys in its direction:
+// with a gap of 1, "qeteu" is a walk on qwerty.
+func spatialWalk(password string, i int, graph *adjacency.Graph, gap int) (j, turns, shiftedCount int) {
+	if shiftedChars[graph.Name][password[i]] {
+		shiftedCount = 1
+	}
+	lastDirection := -99
+	for j = i + 1; j < len(password); j++ {
+		direction, idx := spatialStep(graph, password[j-1], password[j], gap)
+		if direction < 0 {
+			break
+		}
+		if idx == 1 {
+			// index 1 in the adjacency means the key is shifted, 0 means unshifted: A vs a, % vs 5, etc.
+			// for example, 'q' is adjacent to the entry '2@'. @ is shifted w/ index 1, 2 is unshifted.
+			shiftedCount++
+		}
+		if lastDirection != direction {
+			// adding a turn is correct even in the initial case when last_direction is null:
+			// every spatial pattern starts with a turn.
+			turns++
+			lastDirection = direction
+		}
+	}
+	return j, turns, shiftedCount
+}
 
-			// if the current pattern continued, extend j and try to grow again
-			if found {
-				j++
+// spatialStep returns the first direction of graph leading from key prev to key next,
+// skipping gap keys on the way, and the index of next in the adjacent keys, or -1.
+func spatialStep(graph *adjacency.Graph, prev, next byte, gap int) (direction, idx int) {
+	for direction, adj := range graph.Graph[string(prev)] {
+		for k := 0; k < gap && adj != ""; k++ {
+			// go on from the unshifted key in the same direction
+			if adjacents := graph.Graph[adj[:1]]; direction < len(adjacents) {
+				adj = adjacents[direction]
 			} else {
-				// otherwise push the pattern discovered so far, if any...
-				if j-i > 2 {
-					// don't consider length 1 or 2 chains.
-					matchSpc := &match.Match{
-						Pattern:      "spatial",
-						I:            i,
-						J:            j - 1,
-						Token:        password[i:j],
-						Graph:        graph.Name,
-						Turns:        turns,
-						ShiftedCount: shiftedCount,
-					}
-					matches = append(matches, matchSpc)
-				}
-				//. . . and then start a new search from the rest of the password
-				i = j
-				break
+				adj = ""
 			}
 		}
-
+		if adj == "" {
+			continue
+		}
+		if idx := strings.IndexByte(adj, next); idx != -1 {
+			return direction, idx
+		}
 	}
-	return matches
+	return -1, -1
 }
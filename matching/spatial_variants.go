@@ -0,0 +1,163 @@
+package matching
+
+import (
+	"github.com/trustelem/zxcvbn/adjacency"
+	"github.com/trustelem/zxcvbn/match"
+)
+
+// spatialVariantMatch matches keyboard walks spatialMatch leaves out, reported as
+// spatial matches of another SpatialKind:
+//   - "gap": walks skipping keys at each step, "qeteu" or "13579" on a keypad
+//   - "doubled": walks pressing each key several times, "qqwweerr"
+//   - "switch": walks going on from a keyboard to a keypad or back, "qwe789" or "asdf852"
+type spatialVariantMatch struct {
+	graphs []*adjacency.Graph
+	// switches are the pairs of graphs walks switch between, a keyboard and a keypad.
+	switches [][2]*adjacency.Graph
+}
+
+const (
+	// maxSpatialGap is the largest number of keys skipped at each step of a walk.
+	maxSpatialGap = 2
+	// minSwitchSegment is the length of the shortest part of a walk on a single graph,
+	// before or after a switch.
+	minSwitchSegment = 2
+)
+
+var defaultSpatialSwitches = [][2]*adjacency.Graph{
+	{adjacency.Graphs["qwerty"], adjacency.Graphs["keypad"]},
+	{adjacency.Graphs["qwerty"], adjacency.Graphs["mac_keypad"]},
+	{adjacency.Graphs["dvorak"], adjacency.Graphs["keypad"]},
+	{adjacency.Graphs["dvorak"], adjacency.Graphs["mac_keypad"]},
+}
+
+func (s spatialVariantMatch) Matches(password string) (matches []*match.Match) {
+	for _, graph := range s.graphs {
+		for gap := 1; gap <= maxSpatialGap; gap++ {
+			matches = append(matches, gappedSpatialMatches(password, graph, gap)...)
+		}
+		matches = append(matches, doubledSpatialMatches(password, graph)...)
+	}
+	for _, graphs := range s.switches {
+		matches = append(matches, switchSpatialMatches(password, graphs[0], graphs[1])...)
+		matches = append(matches, switchSpatialMatches(password, graphs[1], graphs[0])...)
+	}
+	match.Sort(matches)
+	return matches
+}
+
+func gappedSpatialMatches(password string, graph *adjacency.Graph, gap int) (matches []*match.Match) {
+	i := 0
+	for i < len(password)-1 {
+		j, turns, shiftedCount := spatialWalk(password, i, graph, gap)
+		if j-i > 2 {
+			matches = append(matches, &match.Match{
+				Pattern:      "spatial",
+				I:            i,
+				J:            j - 1,
+				Token:        password[i:j],
+				Graph:        graph.Name,
+				Turns:        turns,
+				ShiftedCount: shiftedCount,
+				SpatialKind:  "gap",
+				Gap:          gap,
+			})
+		}
+		i = j
+	}
+	return matches
+}
+
+func doubledSpatialMatches(password string, graph *adjacency.Graph) (matches []*match.Match) {
+	// keys are the first bytes of the runs of identical bytes of the password
+	var keys []byte
+	var starts []int
+	for k := 0; k < len(password); k++ {
+		if k == 0 || password[k] != password[k-1] {
+			keys = append(keys, password[k])
+			starts = append(starts, k)
+		}
+	}
+	starts = append(starts, len(password))
+	runLength := func(r int) int { return starts[r+1] - starts[r] }
+
+	r := 0
+	for r < len(keys)-1 {
+		repeat := runLength(r)
+		// the walk of the keys from run r, as long as runs are as long as the first
+		end, turns, shiftedCount := r+1, 0, 0
+		if repeat > 1 {
+			e := r + 1
+			for e < len(keys) && runLength(e) == repeat {
+				e++
+			}
+			end, turns, shiftedCount = spatialWalk(string(keys[r:e]), 0, graph, 0)
+			end += r
+		}
+		if end-r > 2 {
+			i, j := starts[r], starts[end]
+			matches = append(matches, &match.Match{
+				Pattern:      "spatial",
+				I:            i,
+				J:            j - 1,
+				Token:        password[i:j],
+				Graph:        graph.Name,
+				Turns:        turns,
+				ShiftedCount: shiftedCount * repeat,
+				SpatialKind:  "doubled",
+				KeyRepeat:    repeat,
+			})
+		}
+		r = end
+	}
+	return matches
+}
+
+// switchSpatialMatches matches walks starting on graph first and switching between it
+// and second, with at least minSwitchSegment keys on each graph in turn.
+func switchSpatialMatches(password string, first, second *adjacency.Graph) (matches []*match.Match) {
+	i := 0
+	for i < len(password)-1 {
+		var segments []*match.Match
+		graph, other := first, second
+		for k := i; k < len(password)-1; {
+			j, turns, shiftedCount := spatialWalk(password, k, graph, 0)
+			if j-k < minSwitchSegment {
+				break
+			}
+			segments = append(segments, &match.Match{
+				Pattern:      "spatial",
+				I:            k,
+				J:            j - 1,
+				Token:        password[k:j],
+				Graph:        graph.Name,
+				Turns:        turns,
+				ShiftedCount: shiftedCount,
+			})
+			k = j
+			graph, other = other, graph
+		}
+		if len(segments) < 2 {
+			i++
+			continue
+		}
+		j := segments[len(segments)-1].J + 1
+		m := &match.Match{
+			Pattern:     "spatial",
+			I:           i,
+			J:           j - 1,
+			Token:       password[i:j],
+			Graph:       first.Name,
+			SpatialKind: "switch",
+			Graphs:      []string{first.Name, second.Name},
+			BaseMatches: segments,
+		}
+		for _, segment := range segments {
+			m.Turns += segment.Turns
+			m.ShiftedCount += segment.ShiftedCount
+		}
+		matches = append(matches, m)
+		i = j
+	}
+	return matches
+}
@@ -0,0 +1,66 @@
+package matching
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustelem/zxcvbn/adjacency"
+	"github.com/trustelem/zxcvbn/match"
+)
+
+func Test_spatialVariantMatch(t *testing.T) {
+	s := spatialVariantMatch{graphs: []*adjacency.Graph{adjacency.Graphs["qwerty"]}}
+
+	assert.Equal(t, []*match.Match{
+		{
+			Pattern:     "spatial",
+			Token:       "qetu",
+			I:           2,
+			J:           5,
+			Graph:       "qwerty",
+			Turns:       1,
+			SpatialKind: "gap",
+			Gap:         1,
+		},
+	}, s.Matches("xxqetu"))
+
+	assert.Equal(t, []*match.Match{
+		{
+			Pattern:      "spatial",
+			Token:        "QQwwee",
+			I:            0,
+			J:            5,
+			Graph:        "qwerty",
+			Turns:        1,
+			ShiftedCount: 2,
+			SpatialKind:  "doubled",
+			KeyRepeat:    2,
+		},
+	}, s.Matches("QQwwee"))
+
+	// runs of keys must be as long as the first one
+	for _, m := range s.Matches("qqwweeerr") {
+		assert.NotEqual(t, "doubled", m.SpatialKind)
+	}
+	// walks without gaps or doubled keys are left to spatialMatch
+	assert.Empty(t, s.Matches("qwerty"))
+
+	s = spatialVariantMatch{switches: [][2]*adjacency.Graph{
+		{adjacency.Graphs["qwerty"], adjacency.Graphs["keypad"]},
+	}}
+	matches := s.Matches("qw78!")
+	if assert.Len(t, matches, 1) {
+		m := matches[0]
+		assert.Equal(t, "switch", m.SpatialKind)
+		assert.Equal(t, "qw78", m.Token)
+		assert.Equal(t, []string{"qwerty", "keypad"}, m.Graphs)
+		assert.Equal(t, 2, m.Turns)
+		if assert.Len(t, m.BaseMatches, 2) {
+			assert.Equal(t, "qwerty", m.BaseMatches[0].Graph)
+			assert.Equal(t, "qw", m.BaseMatches[0].Token)
+			assert.Equal(t, "keypad", m.BaseMatches[1].Graph)
+			assert.Equal(t, "78", m.BaseMatches[1].Token)
+		}
+	}
+	assert.Empty(t, s.Matches("qw"))
+}
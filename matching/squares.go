@@ -0,0 +1,158 @@
+package matching
+
+import (
+	"sort"
+
+	"github.com/trustelem/zxcvbn/internal/mathutils"
+)
+
+// squareStarts are the squares of period period starting from lo to hi: for each k in
+// [lo, hi], s[k:k+period] is repeated right after itself.
+type squareStarts struct {
+	lo, hi, period int
+}
+
+// squarePeriods returns, for each index k of s, the shortest and longest periods of
+// the squares starting at k, tokens made of a base repeated twice like "abab", or 0
+// when no square starts at k. Squares don't span line breaks.
+//
+// Squares are found by the Main-Lorentz algorithm in O(n log n): s is split in two
+// halves, the squares of each half are found recursively, and the squares crossing
+// the middle are found with Z-functions, as ranges of starts per period.
+func squarePeriods(s []rune) (shortest, longest []int) {
+	var squares []squareStarts
+	lo := 0
+	for k := 0; k <= len(s); k++ {
+		if k == len(s) || s[k] == '\n' {
+			if k > lo {
+				findSquares(s[lo:k], lo, &squares)
+			}
+			lo = k + 1
+		}
+	}
+
+	sort.Slice(squares, func(a, b int) bool { return squares[a].period < squares[b].period })
+	shortest = fillPeriods(len(s), squares)
+	for a, b := 0, len(squares)-1; a < b; a, b = a+1, b-1 {
+		squares[a], squares[b] = squares[b], squares[a]
+	}
+	longest = fillPeriods(len(s), squares)
+	return shortest, longest
+}
+
+// fillPeriods returns the period of the first of squares starting at each index.
+func fillPeriods(n int, squares []squareStarts) []int {
+	periods := make([]int, n)
+	// next[k] leads to the first index from k without a period yet
+	next := make([]int, n+1)
+	for k := range next {
+		next[k] = k
+	}
+	var find func(k int) int
+	find = func(k int) int {
+		if next[k] != k {
+			next[k] = find(next[k])
+		}
+		return next[k]
+	}
+	for _, sq := range squares {
+		for k := find(sq.lo); k <= sq.hi; k = find(k) {
+			periods[k] = sq.period
+			next[k] = k + 1
+		}
+	}
+	return periods
+}
+
+// findSquares adds the squares of s, shifted by shift, to squares.
+func findSquares(s []rune, shift int, squares *[]squareStarts) {
+	n := len(s)
+	if n == 1 {
+		return
+	}
+	nu := n / 2
+	nv := n - nu
+	u, v := s[:nu], s[nu:]
+	findSquares(u, shift, squares)
+	findSquares(v, shift+nu, squares)
+
+	ru, rv := reversedRunes(u), reversedRunes(v)
+	z1 := zFunction(ru)
+	z2 := zFunction(joinRunes(v, u))
+	z3 := zFunction(joinRunes(ru, rv))
+	z4 := zFunction(v)
+	for center := 0; center < n; center++ {
+		// the square of period l has its center at center, with k1 characters matching
+		// on its left and k2 on its right
+		var l, k1, k2 int
+		left := center < nu
+		if left {
+			l = nu - center
+			k1 = zAt(z1, nu-center)
+			k2 = zAt(z2, nv+1+center)
+		} else {
+			l = center - nu + 1
+			k1 = zAt(z3, nu+1+nv-1-(center-nu))
+			k2 = zAt(z4, center-nu+1)
+		}
+		if k1+k2 < l {
+			continue
+		}
+		l1Min, l1Max := mathutils.Max(1, l-k2), mathutils.Min(l, k1)
+		if left && l1Max == l {
+			l1Max--
+		}
+		if l1Min > l1Max {
+			continue
+		}
+		sq := squareStarts{period: l}
+		if left {
+			sq.lo, sq.hi = center-l1Max, center-l1Min
+		} else {
+			sq.lo, sq.hi = center-l-l1Max+1, center-l-l1Min+1
+		}
+		sq.lo += shift
+		sq.hi += shift
+		*squares = append(*squares, sq)
+	}
+}
+
+// zFunction returns z, where z[k] is the length of the longest common prefix of s and s[k:].
+func zFunction(s []rune) []int {
+	z := make([]int, len(s))
+	for k, l, r := 1, 0, 0; k < len(s); k++ {
+		if k < r {
+			z[k] = mathutils.Min(r-k, z[k-l])
+		}
+		for k+z[k] < len(s) && s[z[k]] == s[k+z[k]] {
+			z[k]++
+		}
+		if k+z[k] > r {
+			l, r = k, k+z[k]
+		}
+	}
+	return z
+}
+
+func zAt(z []int, k int) int {
+	if k < 0 || k >= len(z) {
+		return 0
+	}
+	return z[k]
+}
+
+func reversedRunes(s []rune) []rune {
+	r := make([]rune, len(s))
+	for k, c := range s {
+		r[len(s)-1-k] = c
+	}
+	return r
+}
+
+// joinRunes returns a and b separated by a rune found in neither.
+func joinRunes(a, b []rune) []rune {
+	s := make([]rune, 0, len(a)+len(b)+1)
+	s = append(s, a...)
+	s = append(s, -1)
+	return append(s, b...)
+}
@@ -0,0 +1,55 @@
+package matching
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// naiveSquarePeriods computes squarePeriods by checking every square.
+func naiveSquarePeriods(s []rune) (shortest, longest []int) {
+	shortest, longest = make([]int, len(s)), make([]int, len(s))
+	for k := range s {
+	periods:
+		for period := 1; k+2*period <= len(s); period++ {
+			for x := k; x < k+period; x++ {
+				if s[x] == '\n' || s[x] != s[x+period] {
+					continue periods
+				}
+			}
+			if shortest[k] == 0 {
+				shortest[k] = period
+			}
+			longest[k] = period
+		}
+	}
+	return shortest, longest
+}
+
+func Test_squarePeriods(t *testing.T) {
+	shortest, longest := squarePeriods([]rune("aabaabx"))
+	assert.Equal(t, []int{1, 0, 0, 1, 0, 0, 0}, shortest)
+	assert.Equal(t, []int{3, 0, 0, 1, 0, 0, 0}, longest)
+
+	r := rand.New(rand.NewSource(1))
+	for _, alphabet := range []string{"ab", "abc", "ab\n", "aé€"} {
+		letters := []rune(alphabet)
+		for n := 0; n < 500; n++ {
+			s := make([]rune, r.Intn(64))
+			for k := range s {
+				s[k] = letters[r.Intn(len(letters))]
+			}
+			shortest, longest := squarePeriods(s)
+			naiveShortest, naiveLongest := naiveSquarePeriods(s)
+			assert.Equal(t, naiveShortest, shortest, string(s))
+			assert.Equal(t, naiveLongest, longest, string(s))
+		}
+	}
+}
+
+func Test_smallestPeriod(t *testing.T) {
+	assert.Equal(t, 1, smallestPeriod([]rune("aaaa")))
+	assert.Equal(t, 3, smallestPeriod([]rune("aabaabaabaab")))
+	assert.Equal(t, 4, smallestPeriod([]rune("abca")))
+}
@@ -0,0 +1,89 @@
+package matching
+
+import (
+	"sync"
+
+	"github.com/trustelem/zxcvbn/match"
+)
+
+// t9Match matches dictionary words typed on a phone keypad, one digit per letter:
+// "7277" spells "pass", and "5683" "love".
+type t9Match struct {
+	dm dictionaryMatch
+}
+
+// minT9Length is the length of the shortest words matched on a phone keypad: shorter
+// digit runs stand for too many words.
+const minT9Length = 4
+
+// t9Keys maps the letters to the digits of their key on a phone keypad.
+var t9Keys = [26]byte{
+	'2', '2', '2', // abc
+	'3', '3', '3', // def
+	'4', '4', '4', // ghi
+	'5', '5', '5', // jkl
+	'6', '6', '6', // mno
+	'7', '7', '7', '7', // pqrs
+	'8', '8', '8', // tuv
+	'9', '9', '9', '9', // wxyz
+}
+
+// t9Code indexes the words of lowercase ASCII letters by the digits that type them.
+func t9Code(word string) (string, bool) {
+	if len(word) < minT9Length {
+		return "", false
+	}
+	digits := make([]byte, len(word))
+	for k := 0; k < len(word); k++ {
+		c := word[k]
+		if c < 'a' || c > 'z' {
+			return "", false
+		}
+		digits[k] = t9Keys[c-'a']
+	}
+	return string(digits), true
+}
+
+var (
+	defaultT9Once  sync.Once
+	defaultT9Index wordIndex
+)
+
+// defaultT9WordIndex indexes the default dictionaries by T9 digits. It is built on first use.
+func defaultT9WordIndex() wordIndex {
+	defaultT9Once.Do(func() {
+		defaultT9Index = newWordIndex(defaultRankedDictionnaries.rankedDictionaries, t9Code)
+	})
+	return defaultT9Index
+}
+
+func (tm t9Match) Matches(password string) []*match.Match {
+	var matches []*match.Match
+	var indexes []wordIndex
+	for i := 0; i < len(password); i++ {
+		for j := i; j < len(password) && '2' <= password[j] && password[j] <= '9'; j++ {
+			if j-i+1 < minT9Length {
+				continue
+			}
+			if indexes == nil {
+				indexes = tm.dm.wordIndexes(defaultT9WordIndex, func(d *RankedDictionary) wordIndex { return d.t9 }, t9Code)
+			}
+			for _, index := range indexes {
+				for _, e := range index.lookup(password[i : j+1]) {
+					matches = append(matches, &match.Match{
+						Pattern:        "t9",
+						I:              i,
+						J:              j,
+						Token:          password[i : j+1],
+						MatchedWord:    e.word,
+						Rank:           e.rank,
+						DictionaryName: e.dictionaryName,
+						Ambiguity:      e.ambiguity,
+					})
+				}
+			}
+		}
+	}
+	match.Sort(matches)
+	return matches
+}
@@ -0,0 +1,94 @@
+package matching
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustelem/zxcvbn/match"
+)
+
+func Test_t9Code(t *testing.T) {
+	for word, want := range map[string]string{
+		"pass":     "7277",
+		"love":     "5683",
+		"password": "72779673",
+		"xyzw":     "9999",
+	} {
+		code, ok := t9Code(word)
+		assert.True(t, ok, word)
+		assert.Equal(t, want, code, word)
+	}
+	for _, word := range []string{"cat", "pa55", "café"} {
+		_, ok := t9Code(word)
+		assert.False(t, ok, word)
+	}
+}
+
+func TestT9Matching(t *testing.T) {
+	dm := dictionaryMatch{
+		rankedDictionaries: map[string]rankedDictionnary{
+			"d1": buildRankedDict([]string{"love", "pass", "sass", "loud"}),
+			"d2": buildRankedDict([]string{"password"}),
+		},
+	}
+	tm := t9Match{dm: dm}
+
+	for _, tt := range []struct {
+		password string
+		want     []*match.Match
+	}{
+		{
+			// "pass" and "sass" have the same digits
+			password: "7277",
+			want: []*match.Match{{
+				Pattern: "t9", I: 0, J: 3, Token: "7277",
+				MatchedWord: "pass", Rank: 2, DictionaryName: "d1", Ambiguity: 2,
+			}},
+		},
+		{
+			// "love" and "loud" too
+			password: "x5683!72779673",
+			want: []*match.Match{
+				{
+					Pattern: "t9", I: 1, J: 4, Token: "5683",
+					MatchedWord: "love", Rank: 1, DictionaryName: "d1", Ambiguity: 2,
+				},
+				{
+					Pattern: "t9", I: 6, J: 9, Token: "7277",
+					MatchedWord: "pass", Rank: 2, DictionaryName: "d1", Ambiguity: 2,
+				},
+				{
+					Pattern: "t9", I: 6, J: 13, Token: "72779673",
+					MatchedWord: "password", Rank: 1, DictionaryName: "d2", Ambiguity: 1,
+				},
+			},
+		},
+		// 0 and 1 type no letter
+		{password: "56083"},
+		{password: "1111"},
+	} {
+		assert.Equal(t, tt.want, tm.Matches(tt.password), tt.password)
+	}
+}
+
+func TestT9MatchingUnicodeDigits(t *testing.T) {
+	dm := dictionaryMatch{
+		rankedDictionaries: map[string]rankedDictionnary{"d1": buildRankedDict([]string{"love"})},
+	}
+	// fullwidth digits
+	matches := digitNormalizedMatch{t9Match{dm: dm}}.Matches("５６８３")
+	if assert.Len(t, matches, 1) {
+		assert.Equal(t, "５６８３", matches[0].Token)
+		assert.Equal(t, "love", matches[0].MatchedWord)
+	}
+}
+
+func TestT9MatchingDictionaries(t *testing.T) {
+	// "trustelem" is in no default dictionary
+	tm := t9Match{dm: defaultRankedDictionnaries.withRankedDictionary("extra", NewRankedDictionary([]string{"trustelem"}))}
+	var found bool
+	for _, m := range tm.Matches("878783536") {
+		found = found || (m.DictionaryName == "extra" && m.MatchedWord == "trustelem")
+	}
+	assert.True(t, found)
+}
@@ -0,0 +1,26 @@
+package matching
+
+import (
+	"github.com/trustelem/zxcvbn/internal/unicodeutils"
+	"github.com/trustelem/zxcvbn/match"
+)
+
+// digitNormalizedMatch runs a matcher on the password with its non-ASCII decimal
+// digits (Arabic-Indic, Devanagari, fullwidth, ...) replaced by ASCII digits.
+// matches keep the offsets and tokens of the original password.
+type digitNormalizedMatch struct {
+	m match.Matcher
+}
+
+func (dn digitNormalizedMatch) Matches(password string) []*match.Match {
+	normalized, offsets := unicodeutils.NormalizeDigits(password)
+	matches := dn.m.Matches(normalized)
+	if offsets == nil {
+		return matches
+	}
+	for _, m := range matches {
+		m.I, m.J = offsets[m.I], offsets[m.J+1]-1
+		m.Token = password[m.I : m.J+1]
+	}
+	return matches
+}
@@ -0,0 +1,65 @@
+package matching
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustelem/zxcvbn/match"
+)
+
+func TestDigitNormalizedMatching(t *testing.T) {
+	// Arabic-Indic date, with offsets in the original password
+	password := "x١٣/٢/١٩٢١"
+	dm := digitNormalizedMatch{dateMatch{referenceYear: 2019}}
+	assert.Equal(t, []*match.Match{
+		{
+			Pattern:   "date",
+			Token:     "١٣/٢/١٩٢١",
+			I:         1,
+			J:         len(password) - 1,
+			Separator: "/",
+			Year:      1921,
+			Month:     2,
+			Day:       13,
+		},
+	}, dm.Matches(password))
+
+	// fullwidth recent year
+	password = "ｐ１９８７"
+	rm := digitNormalizedMatch{regexpMatch{regexes: []namedRegexp{
+		{Name: "recent_year", Regexp: testOptions.recentYearRegexp()},
+	}}}
+	assert.Equal(t, []*match.Match{
+		{
+			Pattern:   "regex",
+			Token:     "１９８７",
+			I:         3,
+			J:         len(password) - 1,
+			RegexName: "recent_year",
+		},
+	}, rm.Matches(password))
+
+	// Devanagari sequence
+	password = "१२३४५"
+	sm := digitNormalizedMatch{sequenceMatch{}}
+	assert.Equal(t, []*match.Match{
+		{
+			Pattern:       "sequence",
+			Token:         password,
+			I:             0,
+			J:             len(password) - 1,
+			SequenceName:  "digits",
+			SequenceSpace: 10,
+			Ascending:     true,
+		},
+	}, sm.Matches(password))
+
+	// ASCII passwords are matched as is
+	assert.Equal(t, sequenceMatch{}.Matches("abc123"), sm.Matches("abc123"))
+
+	// mixed scripts still form dates
+	matches := digitNormalizedMatch{dateMatch{referenceYear: 2019}}.Matches("1٩٩1")
+	if assert.Len(t, matches, 1) {
+		assert.Equal(t, "1٩٩1", matches[0].Token)
+	}
+}
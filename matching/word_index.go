@@ -0,0 +1,108 @@
+package matching
+
+import "sort"
+
+// wordIndex maps the codes of dictionary words, such as their skeleton ("psswrd") or
+// their phone keypad digits ("7277"), to the best ranked word of each dictionary with
+// the code.
+type wordIndex struct {
+	codes map[string][]indexedWord
+	// maxCodeLength is the length in bytes of the longest code.
+	maxCodeLength int
+	// dictionaryName, if set, is the name of the dictionary of every word: the index of
+	// a RankedDictionary is built before it is named.
+	dictionaryName string
+}
+
+// lookup returns the words with code.
+func (index wordIndex) lookup(code string) []indexedWord {
+	entries := index.codes[code]
+	if index.dictionaryName == "" || len(entries) == 0 {
+		return entries
+	}
+	named := make([]indexedWord, len(entries))
+	for k, e := range entries {
+		named[k] = e
+		named[k].dictionaryName = index.dictionaryName
+	}
+	return named
+}
+
+// indexedWord is the best ranked word of a dictionary with a given code.
+type indexedWord struct {
+	dictionaryName string
+	word           string
+	rank           int
+	// ambiguity is the number of words of the dictionary with the code.
+	ambiguity int
+}
+
+// newWordIndex indexes the words of dicts by code. Words are left out when code
+// returns false.
+func newWordIndex(dicts map[string]rankedDictionnary, code func(word string) (string, bool)) wordIndex {
+	names := make([]string, 0, len(dicts))
+	for name := range dicts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	index := wordIndex{codes: make(map[string][]indexedWord)}
+	for _, name := range names {
+		for word, rank := range dicts[name] {
+			c, ok := code(word)
+			if !ok {
+				continue
+			}
+			if len(c) > index.maxCodeLength {
+				index.maxCodeLength = len(c)
+			}
+			entries := index.codes[c]
+			if n := len(entries); n > 0 && entries[n-1].dictionaryName == name {
+				e := &entries[n-1]
+				e.ambiguity++
+				if rank < e.rank {
+					e.word, e.rank = word, rank
+				}
+				continue
+			}
+			index.codes[c] = append(entries, indexedWord{dictionaryName: name, word: word, rank: rank, ambiguity: 1})
+		}
+	}
+	return index
+}
+
+// wordIndexes returns the indexes by code of the dictionaries of dm: defaultIndex, for the
+// default dictionaries, the index of each RankedDictionary of dm.extra that prebuilt
+// returns, and one of the other dictionaries, such as the user inputs.
+func (dm dictionaryMatch) wordIndexes(defaultIndex func() wordIndex, prebuilt func(d *RankedDictionary) wordIndex, code func(word string) (string, bool)) []wordIndex {
+	for name := range defaultRankedDictionnaries.rankedDictionaries {
+		if _, ok := dm.rankedDictionaries[name]; !ok {
+			return []wordIndex{newWordIndex(dm.rankedDictionaries, code)}
+		}
+	}
+	indexes := []wordIndex{defaultIndex()}
+	names := make([]string, 0, len(dm.extra))
+	for name := range dm.extra {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		index := prebuilt(dm.extra[name])
+		index.dictionaryName = name
+		indexes = append(indexes, index)
+	}
+	others := make(map[string]rankedDictionnary)
+	for name, d := range dm.rankedDictionaries {
+		if _, ok := defaultRankedDictionnaries.rankedDictionaries[name]; ok {
+			continue
+		}
+		if _, ok := dm.extra[name]; ok || len(d) == 0 {
+			continue
+		}
+		others[name] = d
+	}
+	if len(others) > 0 {
+		indexes = append(indexes, newWordIndex(others, code))
+	}
+	return indexes
+}
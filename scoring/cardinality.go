@@ -0,0 +1,128 @@
+package scoring
+
+import (
+	"math"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/trustelem/zxcvbn/match"
+)
+
+// Cardinalities of the classes of ASCII characters.
+const (
+	DigitCardinality     = 10
+	LowercaseCardinality = 26
+	UppercaseCardinality = 26
+	// SymbolCardinality counts the printable ASCII characters that are not letters or
+	// digits, space included.
+	SymbolCardinality = 33
+	// unlistedBlockCardinality is the size of the pseudo-blocks of code points outside
+	// unicodeBlocks.
+	unlistedBlockCardinality = 128
+)
+
+// unicodeBlock is a block of code points, lo to hi.
+type unicodeBlock struct {
+	lo, hi rune
+}
+
+// unicodeBlocks are the Unicode blocks of the letters and symbols most found in
+// passwords, sorted. The characters of the other blocks are counted in blocks of 128
+// code points.
+var unicodeBlocks = []unicodeBlock{
+	{0x0080, 0x00FF},   // Latin-1 Supplement
+	{0x0100, 0x017F},   // Latin Extended-A
+	{0x0180, 0x024F},   // Latin Extended-B
+	{0x0250, 0x02AF},   // IPA Extensions
+	{0x0370, 0x03FF},   // Greek and Coptic
+	{0x0400, 0x04FF},   // Cyrillic
+	{0x0530, 0x058F},   // Armenian
+	{0x0590, 0x05FF},   // Hebrew
+	{0x0600, 0x06FF},   // Arabic
+	{0x0900, 0x097F},   // Devanagari
+	{0x0980, 0x09FF},   // Bengali
+	{0x0E00, 0x0E7F},   // Thai
+	{0x10A0, 0x10FF},   // Georgian
+	{0x1100, 0x11FF},   // Hangul Jamo
+	{0x1E00, 0x1EFF},   // Latin Extended Additional
+	{0x1F00, 0x1FFF},   // Greek Extended
+	{0x2000, 0x206F},   // General Punctuation
+	{0x20A0, 0x20CF},   // Currency Symbols
+	{0x2100, 0x214F},   // Letterlike Symbols
+	{0x2190, 0x21FF},   // Arrows
+	{0x2200, 0x22FF},   // Mathematical Operators
+	{0x2500, 0x257F},   // Box Drawing
+	{0x2600, 0x26FF},   // Miscellaneous Symbols
+	{0x2700, 0x27BF},   // Dingbats
+	{0x3000, 0x303F},   // CJK Symbols and Punctuation
+	{0x3040, 0x309F},   // Hiragana
+	{0x30A0, 0x30FF},   // Katakana
+	{0x3130, 0x318F},   // Hangul Compatibility Jamo
+	{0x3400, 0x4DBF},   // CJK Unified Ideographs Extension A
+	{0x4E00, 0x9FFF},   // CJK Unified Ideographs
+	{0xAC00, 0xD7AF},   // Hangul Syllables
+	{0xFF00, 0xFFEF},   // Halfwidth and Fullwidth Forms
+	{0x1F300, 0x1F5FF}, // Miscellaneous Symbols and Pictographs
+	{0x1F600, 0x1F64F}, // Emoticons
+	{0x1F680, 0x1F6FF}, // Transport and Map Symbols
+	{0x1F900, 0x1F9FF}, // Supplemental Symbols and Pictographs
+}
+
+// characterBlock returns the class of r, as the first code point of its block, and
+// the cardinality of the class. ASCII characters are split into digits, lowercase and
+// uppercase letters and symbols.
+func characterBlock(r rune) (rune, int) {
+	switch {
+	case r >= '0' && r <= '9':
+		return '0', DigitCardinality
+	case r >= 'a' && r <= 'z':
+		return 'a', LowercaseCardinality
+	case r >= 'A' && r <= 'Z':
+		return 'A', UppercaseCardinality
+	case r < utf8.RuneSelf:
+		return ' ', SymbolCardinality
+	}
+	i := sort.Search(len(unicodeBlocks), func(i int) bool {
+		return unicodeBlocks[i].hi >= r
+	})
+	if i < len(unicodeBlocks) && unicodeBlocks[i].lo <= r {
+		return unicodeBlocks[i].lo, int(unicodeBlocks[i].hi-unicodeBlocks[i].lo) + 1
+	}
+	return r &^ (unlistedBlockCardinality - 1), unlistedBlockCardinality
+}
+
+// CharacterClassCardinality returns the number of characters of the classes present in
+// token: 10 for digits, 26 for lowercase letters, 52 for mixed case letters, 95 with
+// digits and symbols, plus the size of the Unicode block of each other character, such
+// as 20992 for CJK ideographs.
+func CharacterClassCardinality(token string) int {
+	var seen []rune
+	cardinality := 0
+	for _, r := range token {
+		block, size := characterBlock(r)
+		found := false
+		for _, b := range seen {
+			if b == block {
+				found = true
+				break
+			}
+		}
+		if !found {
+			seen = append(seen, block)
+			cardinality += size
+		}
+	}
+	return cardinality
+}
+
+// ClassBruteforceGuesses estimates the guesses of a bruteforce match from the
+// character classes present in its token, instead of BruteforceCardinality guesses per
+// character: "qxjzvkw" takes 26^7 guesses.
+func ClassBruteforceGuesses(m *match.Match) float64 {
+	runeCount := utf8.RuneCountInString(m.Token)
+	guesses := math.Pow(float64(CharacterClassCardinality(m.Token)), float64(runeCount))
+	if minGuesses := bruteforceMinGuesses(m); guesses < minGuesses {
+		return minGuesses
+	}
+	return guesses
+}
@@ -0,0 +1,54 @@
+package scoring_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustelem/zxcvbn/match"
+	"github.com/trustelem/zxcvbn/scoring"
+)
+
+func TestCharacterClassCardinality(t *testing.T) {
+	for _, tt := range []struct {
+		token       string
+		cardinality int
+	}{
+		{"", 0},
+		{"1234", 10},
+		{"qxjzvkw", 26},
+		{"QXJZ", 26},
+		{"qxJZ", 52},
+		{"qx12", 36},
+		{"!@ #", 33},
+		{"aB1!", 95},
+		{"пароль", 256},
+		{"Пароль1", 266},
+		{"ü", 128},
+		{"üő", 256},
+		{"密码", 20992},
+		{"パスワード", 96},
+		{"비밀번호", 11184},
+		{"😀🙏", 80},
+		{"\U0001D11E", 128}, // unlisted block
+	} {
+		assert.Equal(t, tt.cardinality, scoring.CharacterClassCardinality(tt.token), tt.token)
+	}
+}
+
+func TestClassBruteforceGuesses(t *testing.T) {
+	guesses := func(token string) float64 {
+		return scoring.ClassBruteforceGuesses(&match.Match{Token: token})
+	}
+	assert.Equal(t, math.Pow(26, 7), guesses("qxjzvkw"))
+	assert.Equal(t, math.Pow(95, 4), guesses("aB1!"))
+	assert.Equal(t, math.Pow(20992, 2), guesses("密码"))
+	assert.Equal(t, float64(1e4), guesses("1234"))
+	assert.Equal(t, float64(scoring.MinSubmatchGuessesSingleChar+1), guesses("1"))
+
+	m := &match.Match{Pattern: "bruteforce", I: 0, J: 6, Token: "qxjzvkw"}
+	scorer := scoring.Scorer{ReferenceYear: 2019, CharacterClassCardinality: true}
+	assert.Equal(t, math.Pow(26, 7), scorer.EstimateGuesses(m, "qxjzvkw"))
+	m.Guesses = 0
+	assert.Equal(t, float64(1e7), testScorer.EstimateGuesses(m, "qxjzvkw"))
+}
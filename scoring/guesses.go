@@ -1,15 +1,18 @@
 package scoring
 
 import (
+	"errors"
+	"fmt"
 	"math"
-	"regexp"
+	"sort"
 	"strconv"
 	"strings"
-	"time"
+	"unicode"
 	"unicode/utf8"
 
 	"github.com/trustelem/zxcvbn/adjacency"
 	"github.com/trustelem/zxcvbn/internal/mathutils"
+	"github.com/trustelem/zxcvbn/internal/unicodeutils"
 	"github.com/trustelem/zxcvbn/match"
 )
 
@@ -18,37 +21,68 @@ const (
 	MinGuessesBeforeGrowingSequence = 10000
 	MinSubmatchGuessesSingleChar    = 10
 	MinSubmatchGuessesMultiChar     = 50
+
+	// Separators are the characters separating the words of passphrases and the tokens
+	// of separated matches.
+	Separators = " -_.,~+=:;/|*"
 )
 
-func EstimateGuesses(m *match.Match, password string) float64 {
+// MinSubmatchGuesses returns the fewest guesses a match of token takes when it
+// doesn't cover the whole password.
+func MinSubmatchGuesses(token string) float64 {
+	if len(token) == 1 {
+		return MinSubmatchGuessesSingleChar
+	}
+	return MinSubmatchGuessesMultiChar
+}
+
+// EstimateGuesses returns the number of guesses needed to find m inside password,
+// caching the result in m.Guesses.
+func (s Scorer) EstimateGuesses(m *match.Match, password string) float64 {
 	if m.Guesses > 0 {
 		// a match's guess estimate doesn't change. cache it.
 		return m.Guesses
 	}
 	minGuesses := float64(1)
 	if len(m.Token) < len(password) {
-		if len(m.Token) == 1 {
-			minGuesses = MinSubmatchGuessesSingleChar
-		} else {
-			minGuesses = MinSubmatchGuessesMultiChar
-		}
+		minGuesses = MinSubmatchGuesses(m.Token)
 	}
 	var guesses float64
 	switch m.Pattern {
 	case "bruteforce":
-		guesses = BruteforceGuesses(m)
+		guesses = s.bruteforceGuesses(m)
 	case "dictionary":
 		guesses = DictionaryGuesses(m)
+	case "keyboard_layout":
+		guesses = KeyboardLayoutGuesses(m)
+	case "t9":
+		guesses = T9Guesses(m)
+	case "passphrase":
+		guesses = PassphraseGuesses(m)
+	case "separated":
+		guesses = SeparatedGuesses(m)
+	case "palindrome":
+		guesses = PalindromeGuesses(m)
 	case "spatial":
-		guesses = SpatialGuesses(m)
+		if s.GraphSpatialScoring {
+			var err error
+			if guesses, err = GraphSpatialGuesses(m); err != nil {
+				// walks on graphs we know nothing about are priced as random characters,
+				// and say so
+				m.UnknownGraph = true
+				guesses = BruteforceGuesses(m)
+			}
+		} else {
+			guesses = SpatialGuesses(m)
+		}
 	case "repeat":
 		guesses = RepeatGuesses(m)
 	case "sequence":
 		guesses = SequenceGuesses(m)
 	case "regex":
-		guesses = RegexGuesses(m)
+		guesses = s.RegexGuesses(m)
 	case "date":
-		guesses = DateGuesses(m)
+		guesses = s.DateGuesses(m)
 	default:
 		// panic("unknown pattern " + m.Pattern)
 	}
@@ -59,27 +93,43 @@ func EstimateGuesses(m *match.Match, password string) float64 {
 	return m.Guesses
 }
 
+// bruteforceGuesses prices bruteforce matches with the Markov model of the scorer, if
+// any, or with the cardinality of their character classes if enabled.
+func (s Scorer) bruteforceGuesses(m *match.Match) float64 {
+	if s.Markov != nil {
+		if logP, variations, ok := s.markovSegments.segment(m); ok {
+			return s.Markov.guesses(m, logP, variations)
+		}
+		return s.Markov.Guesses(m)
+	}
+	if s.CharacterClassCardinality {
+		return ClassBruteforceGuesses(m)
+	}
+	return BruteforceGuesses(m)
+}
+
 func BruteforceGuesses(m *match.Match) float64 {
 	runeCount := utf8.RuneCountInString(m.Token)
 	guesses := math.Pow(BruteforceCardinality, float64(runeCount))
 	/* if guesses == Number.POSITIVE_INFINITY {
 		guesses = math.MaxInt;
 	}*/
-	// small detail: make bruteforce matches at minimum one guess bigger than smallest allowed
-	// submatch guesses, such that non-bruteforce submatches over the same [i..j] take precedence.
-	minGuesses := float64(0)
-	if runeCount == 1 {
-		minGuesses = MinSubmatchGuessesSingleChar + 1
-	} else {
-		minGuesses = MinSubmatchGuessesMultiChar + 1
-	}
-
-	if guesses < minGuesses {
+	if minGuesses := bruteforceMinGuesses(m); guesses < minGuesses {
 		return minGuesses
 	}
 	return guesses
 }
 
+// bruteforceMinGuesses returns the fewest guesses of a bruteforce match.
+func bruteforceMinGuesses(m *match.Match) float64 {
+	// small detail: make bruteforce matches at minimum one guess bigger than smallest allowed
+	// submatch guesses, such that non-bruteforce submatches over the same [i..j] take precedence.
+	if utf8.RuneCountInString(m.Token) == 1 {
+		return MinSubmatchGuessesSingleChar + 1
+	}
+	return MinSubmatchGuessesMultiChar + 1
+}
+
 func DictionaryGuesses(m *match.Match) float64 {
 	m.BaseGuesses = float64(m.Rank)
 	m.UppercaseVariations = UppercaseVariations(m.Token)
@@ -88,43 +138,75 @@ func DictionaryGuesses(m *match.Match) float64 {
 	if m.Reversed {
 		reversedVariations = 2
 	}
-	return float64(m.BaseGuesses) * float64(m.UppercaseVariations) * float64(m.L33tVariations) * float64(reversedVariations)
+	foldVariations := float64(1)
+	if m.Folded {
+		m.FoldVariations = FoldVariations(m)
+		foldVariations = m.FoldVariations
+	}
+	editVariations := float64(1)
+	if len(m.Edits) > 0 {
+		m.EditVariations = EditVariations(m)
+		editVariations = m.EditVariations
+	}
+	abbreviationVariations := float64(1)
+	if m.Abbreviation != "" {
+		m.AbbreviationVariations = AbbreviationVariations(m)
+		abbreviationVariations = m.AbbreviationVariations
+	}
+	return float64(m.BaseGuesses) * float64(m.UppercaseVariations) * float64(m.L33tVariations) * float64(reversedVariations) * foldVariations * editVariations * abbreviationVariations
 }
 
-var reStartUpper = regexp.MustCompile(`^[A-Z][^A-Z]+$`)
-var reEndUpper = regexp.MustCompile(`^[^A-Z]+[A-Z]$`)
-var reAllUpper = regexp.MustCompile(`^[^a-z]+$`)
-var reAllLower = regexp.MustCompile(`^[^A-Z]+$`)
+// isUpper reports whether c is an uppercase or titlecase letter ("ǅ"), in any script.
+func isUpper(c rune) bool {
+	return unicode.IsUpper(c) || unicode.IsTitle(c)
+}
 
 func UppercaseVariations(w string) float64 {
-	if reAllLower.MatchString(w) || strings.ToLower(w) == w {
+	u := 0 // num of uppercase letters
+	l := 0 // num of lowercase letters
+	n := 0 // num of characters
+	firstUpper, lastUpper := false, false
+	for _, c := range w {
+		upper := isUpper(c)
+		if upper {
+			u++
+		} else if unicode.IsLower(c) {
+			l++
+		}
+		firstUpper = firstUpper || (n == 0 && upper)
+		lastUpper = upper
+		n++
+	}
+	return caseVariations(u, l, n, firstUpper, lastUpper, binomialSum)
+}
+
+// caseVariations counts the uppercase variations of a token of n characters, with u
+// uppercase and l lowercase letters, given sum(n, k), the sum of NCk(n, i) for i from
+// 1 to k.
+func caseVariations(u, l, n int, firstUpper, lastUpper bool, sum func(n, k int) float64) float64 {
+	if u == 0 {
 		return 1
 	}
 	// a capitalized word is the most common capitalization scheme,
 	// so it only doubles the search space (uncapitalized + capitalized).
 	// allcaps and end-capitalized are common enough too, underestimate as 2x factor to be safe.
-	if reStartUpper.MatchString(w) ||
-		reEndUpper.MatchString(w) ||
-		reAllUpper.MatchString(w) {
+	startUpper := u == 1 && n > 1 && firstUpper
+	endUpper := u == 1 && n > 1 && lastUpper
+	allUpper := l == 0
+	if startUpper || endUpper || allUpper {
 		return 2
 	}
 	// otherwise calculate the number of ways to capitalize U+L uppercase+lowercase letters
 	// with U uppercase letters or less. or, if there's more uppercase than lower (for eg. PASSwORD),
 	// the number of ways to lowercase U+L letters with L lowercase letters or less.
-	u := 0
-	l := 0
-	for _, c := range w {
-		if c >= 'A' && c <= 'Z' {
-			u++
-			continue
-		}
-		if c >= 'a' && c <= 'z' {
-			l++
-		}
-	}
+	return sum(u+l, mathutils.Min(u, l))
+}
+
+// binomialSum returns the sum of NCk(n, i) for i from 1 to k.
+func binomialSum(n, k int) float64 {
 	variations := float64(0)
-	for i := 1; i <= u && i <= l; i++ {
-		variations += mathutils.NCk(u+l, i)
+	for i := 1; i <= k; i++ {
+		variations += mathutils.NCk(n, i)
 	}
 	return variations
 }
@@ -135,18 +217,24 @@ func L33tVariations(m *match.Match) float64 {
 	}
 	// lower-case match.token before calculating: capitalization shouldn't affect l33t calc.
 	chrs := strings.ToLower(m.Token)
-	variations := float64(1)
-	for subbed, unsubbed := range m.Sub {
-		s := 0 // num of subbed chars
-		u := 0 // num of unsubbed chars
-		for _, c := range chrs {
-			if string(c) == subbed {
-				s++
-			}
-			if string(c) == unsubbed {
-				u++
-			}
+	// substitutions can be several characters long ("|_|" for u, "ph" for f):
+	// count the longest first, and mask them so that "|_|" doesn't also count as two "|".
+	subs := make([]string, 0, len(m.Sub))
+	for subbed := range m.Sub {
+		subs = append(subs, subbed)
+	}
+	sort.Slice(subs, func(i, j int) bool {
+		if len(subs[i]) != len(subs[j]) {
+			return len(subs[i]) > len(subs[j])
 		}
+		return subs[i] < subs[j]
+	})
+	variations := float64(1)
+	for _, subbed := range subs {
+		unsubbed := m.Sub[subbed]
+		s := strings.Count(chrs, subbed)   // num of subbed chars
+		u := strings.Count(chrs, unsubbed) // num of unsubbed chars
+		chrs = strings.ReplaceAll(chrs, subbed, "\x00")
 		if s == 0 || u == 0 {
 			// for this sub, password is either fully subbed (444) or fully unsubbed (aaa)
 			// treat that as doubling the space (attacker needs to try fully subbed chars in addition to
@@ -166,21 +254,174 @@ func L33tVariations(m *match.Match) float64 {
 	return variations
 }
 
+// FoldVariations counts the variations of a dictionary word matched after Unicode
+// folding, the same way L33tVariations does for l33t substitutions: m.Folds maps the
+// folded characters of the token ("ö", Cyrillic "о") to what they stand for ("o").
+func FoldVariations(m *match.Match) float64 {
+	if !m.Folded {
+		return 1
+	}
+	chrs := strings.ToLower(m.Token)
+	variations := float64(1)
+	for folded, unfolded := range m.Folds {
+		s := strings.Count(chrs, folded) // num of folded chars
+		u := 0                           // num of unfolded chars
+		if unfolded != "" {
+			// a removed combining mark stands for nothing: it was added or not
+			u = strings.Count(chrs, unfolded)
+		}
+		if s == 0 || u == 0 {
+			variations *= 2
+		} else {
+			possibilities := float64(0)
+			for i := 1; i <= mathutils.Min(u, s); i++ {
+				possibilities += mathutils.NCk(u+s, i)
+			}
+			variations *= possibilities
+		}
+	}
+	return variations
+}
+
+// editAlphabetSize is the number of characters a typo can substitute or insert.
+const editAlphabetSize = 26
+
+// EditVariations counts the ways of making len(m.Edits) typos in m.MatchedWord: with n
+// letters, there are n deletions, n-1 transpositions, 25n substitutions and 26(n+1)
+// insertions for each typo, in any order.
+func EditVariations(m *match.Match) float64 {
+	if len(m.Edits) == 0 {
+		return 1
+	}
+	n := utf8.RuneCountInString(m.MatchedWord)
+	edits := float64(n + n - 1 + (editAlphabetSize-1)*n + editAlphabetSize*(n+1))
+	return math.Pow(edits, float64(len(m.Edits))) / mathutils.Factorial(len(m.Edits))
+}
+
+// AbbreviationVariations counts the variations of an abbreviated dictionary word: the
+// word is abbreviated or not, and the abbreviation stands for m.Ambiguity words of the
+// dictionary.
+func AbbreviationVariations(m *match.Match) float64 {
+	if m.Abbreviation == "" {
+		return 1
+	}
+	return 2 * float64(mathutils.Max(m.Ambiguity, 1))
+}
+
+// KeyboardLayoutGuesses estimates the guesses of a dictionary word typed in the wrong
+// keyboard layout: the guesses of the word, times the number of ways to switch layouts.
+func KeyboardLayoutGuesses(m *match.Match) float64 {
+	m.BaseGuesses = float64(m.Rank)
+	m.UppercaseVariations = UppercaseVariations(m.Token)
+	m.LayoutVariations = LayoutVariations(m)
+	return m.BaseGuesses * m.UppercaseVariations * m.LayoutVariations
+}
+
+// LayoutVariations counts the ways to type a word in the wrong layout: each of the
+// m.LayoutPairs pairs of layouts is tried both ways.
+func LayoutVariations(m *match.Match) float64 {
+	return 2 * float64(mathutils.Max(m.LayoutPairs, 1))
+}
+
+// T9Guesses estimates the guesses of a dictionary word typed on a phone keypad: the
+// digits stand for m.Ambiguity words of the dictionary, the best ranked being m.Rank.
+func T9Guesses(m *match.Match) float64 {
+	m.BaseGuesses = float64(m.Rank)
+	return m.BaseGuesses * float64(mathutils.Max(m.Ambiguity, 1))
+}
+
+// PassphraseGuesses estimates the guesses of a passphrase as a choice of len(m.Words)
+// words among m.Rank words, times the choice of the separator and the uppercase
+// variations of each word.
+func PassphraseGuesses(m *match.Match) float64 {
+	m.BaseGuesses = math.Pow(float64(m.Rank), float64(len(m.Words)))
+	m.UppercaseVariations = 1
+	for _, word := range strings.Split(m.Token, m.Separator) {
+		m.UppercaseVariations *= UppercaseVariations(word)
+	}
+	separators := float64(utf8.RuneCountInString(Separators))
+	return m.BaseGuesses * separators * m.UppercaseVariations
+}
+
+// SeparatedGuesses estimates the guesses of tokens joined by a separator: the guesses
+// of the tokens, times the choice of the separator, which is paid for once.
+func SeparatedGuesses(m *match.Match) float64 {
+	return m.BaseGuesses * float64(utf8.RuneCountInString(Separators))
+}
+
+// ErrUnknownGraph is returned by GraphSpatialGuesses for walks on graphs missing from
+// adjacency.Graphs.
+var ErrUnknownGraph = errors.New("scoring: unknown adjacency graph")
+
+// SpatialGuesses estimates the guesses of a keyboard walk as upstream zxcvbn 4.4.2 does,
+// with the qwerty graph for qwerty and dvorak and the keypad graph for any other graph.
+// Walks with gaps take the guesses of a walk as long, times the choice of the gap;
+// walks with doubled keys the guesses of the walk of their keys, times the choice of
+// the repeat factor; walks switching graphs the guesses of their parts, doubled at
+// each switch.
 func SpatialGuesses(m *match.Match) float64 {
-	s := float64(0)
-	d := float64(0)
-	switch m.Graph {
+	guesses, _ := spatialGuesses(m, upstreamSpatialGraph)
+	return guesses
+}
+
+// GraphSpatialGuesses estimates the guesses of a keyboard walk like SpatialGuesses,
+// but with the number of keys and the average degree of the graph the walk was typed
+// on. It returns ErrUnknownGraph if the graph isn't in adjacency.Graphs.
+func GraphSpatialGuesses(m *match.Match) (float64, error) {
+	return spatialGuesses(m, func(name string) (*adjacency.Graph, error) {
+		if g, ok := adjacency.Graphs[name]; ok {
+			return g, nil
+		}
+		return nil, fmt.Errorf("%w %q", ErrUnknownGraph, name)
+	})
+}
+
+// upstreamSpatialGraph returns the graph upstream zxcvbn prices walks on graph name with.
+func upstreamSpatialGraph(name string) (*adjacency.Graph, error) {
+	switch name {
 	case "qwerty", "dvorak":
-		s = float64(len(adjacency.Graphs["qwerty"].Graph))
-		d = adjacency.Graphs["qwerty"].AverageDegree
+		return adjacency.Graphs["qwerty"], nil
 	default:
-		s = float64(len(adjacency.Graphs["keypad"].Graph))
-		d = adjacency.Graphs["keypad"].AverageDegree
+		return adjacency.Graphs["keypad"], nil
+	}
+}
+
+func spatialGuesses(m *match.Match, graph func(name string) (*adjacency.Graph, error)) (float64, error) {
+	if m.SpatialKind == "switch" {
+		guesses := float64(1)
+		for k, segment := range m.BaseMatches {
+			g, err := spatialGuesses(segment, graph)
+			if err != nil {
+				return 0, err
+			}
+			guesses *= g
+			if k > 0 {
+				guesses *= 2
+			}
+		}
+		return guesses, nil
+	}
+	g, err := graph(m.Graph)
+	if err != nil {
+		return 0, err
 	}
-	guesses := float64(0)
 	runeCount := utf8.RuneCountInString(m.Token)
+	switch m.SpatialKind {
+	case "gap":
+		return spatialWalkGuesses(g, runeCount, m.Turns, m.ShiftedCount) * float64(m.Gap+1), nil
+	case "doubled":
+		repeat := mathutils.Max(m.KeyRepeat, 1)
+		return spatialWalkGuesses(g, runeCount/repeat, m.Turns, m.ShiftedCount/repeat) * float64(repeat), nil
+	}
+	return spatialWalkGuesses(g, runeCount, m.Turns, m.ShiftedCount), nil
+}
+
+func spatialWalkGuesses(graph *adjacency.Graph, runeCount, turns, shiftedCount int) float64 {
+	s := float64(len(graph.Graph))
+	d := graph.AverageDegree
+	guesses := float64(0)
 	l := runeCount
-	t := m.Turns
+	t := turns
 	// estimate the number of possible patterns w/ length L or less with t turns or less.
 	for i := 2; i <= l; i++ {
 		possibleTurns := mathutils.Min(t, i-1)
@@ -190,9 +431,9 @@ func SpatialGuesses(m *match.Match) float64 {
 	}
 	// add extra guesses for shifted keys. (% instead of 5, A instead of a.)
 	// math is similar to extra guesses of l33t substitutions in dictionary matches.
-	if m.ShiftedCount > 0 {
-		s := m.ShiftedCount
-		u := runeCount - m.ShiftedCount // unshifted count
+	if shiftedCount > 0 {
+		s := shiftedCount
+		u := runeCount - shiftedCount // unshifted count
 		if s == 0 || u == 0 {
 			guesses *= 2
 		} else {
@@ -207,11 +448,45 @@ func SpatialGuesses(m *match.Match) float64 {
 }
 
 func RepeatGuesses(m *match.Match) float64 {
+	if len(m.RepeatVariants) > 0 {
+		m.RepeatVariations = RepeatVariations(m)
+		return float64(m.BaseGuesses) * float64(m.RepeatCount) * m.RepeatVariations
+	}
 	return float64(m.BaseGuesses) * float64(m.RepeatCount)
 }
 
+// RepeatVariations counts the ways of varying the repetitions of a repeat: the case of
+// a repetition varies like a dictionary word's, each l33t substitution doubles the
+// variations and reversing a repetition doubles them too.
+func RepeatVariations(m *match.Match) float64 {
+	variations := float64(1)
+	for _, v := range m.RepeatVariants {
+		if v.Case {
+			variations *= math.Max(UppercaseVariations(v.Token), 2)
+		}
+		variations *= math.Pow(2, float64(v.L33tSubs))
+		if v.Reversed {
+			variations *= 2
+		}
+	}
+	return variations
+}
+
+// PalindromeGuesses estimates the guesses of a palindrome: the guesses of its first
+// half, times 2 for mirroring it, and times the guesses of a single character for its
+// pivot, if any.
+func PalindromeGuesses(m *match.Match) float64 {
+	guesses := m.BaseGuesses * 2
+	if m.Pivot != "" {
+		guesses *= MinSubmatchGuessesSingleChar
+	}
+	return guesses
+}
+
 func SequenceGuesses(m *match.Match) float64 {
-	firstChr := m.Token[0]
+	// sequences of non-ASCII digits are matched as ASCII digits
+	token, _ := unicodeutils.NormalizeDigits(m.Token)
+	firstChr := token[0]
 	// lower guesses for obvious starting points
 	baseGuesses := 0
 	switch firstChr {
@@ -231,10 +506,10 @@ func SequenceGuesses(m *match.Match) float64 {
 		// 2x guesses
 		baseGuesses *= 2
 	}
-	return float64(baseGuesses * len(m.Token))
+	return float64(baseGuesses * len(token))
 }
 
-func RegexGuesses(m *match.Match) float64 {
+func (s Scorer) RegexGuesses(m *match.Match) float64 {
 	switch m.RegexName {
 	case "alpha_lower":
 		return math.Pow(26, float64(len(m.Token)))
@@ -249,10 +524,11 @@ func RegexGuesses(m *match.Match) float64 {
 	case "symbols":
 		return math.Pow(33, float64(len(m.Token)))
 	case "recent_year":
-		// conservative estimate of year space: num years from REFERENCE_YEAR.
-		// if year is close to REFERENCE_YEAR, estimate a year space of MIN_YEAR_SPACE.
-		year, _ := strconv.Atoi(m.Token)
-		yearSpace := mathutils.Abs(year - ReferenceYear)
+		// conservative estimate of year space: num years from s.ReferenceYear.
+		// if year is close to s.ReferenceYear, estimate a year space of MinYearSpace.
+		token, _ := unicodeutils.NormalizeDigits(m.Token)
+		year, _ := strconv.Atoi(token)
+		yearSpace := mathutils.Abs(year - s.ReferenceYear)
 		yearSpace = mathutils.Max(yearSpace, MinYearSpace)
 		return float64(yearSpace)
 	default:
@@ -262,15 +538,49 @@ func RegexGuesses(m *match.Match) float64 {
 
 const MinYearSpace = 20
 
-var ReferenceYear = time.Now().Year()
-
-func DateGuesses(m *match.Match) float64 {
-	// base guesses: (year distance from ReferenceYear) * num_days * num_years
-	yearSpace := mathutils.Max(mathutils.Abs(m.Year-ReferenceYear), MinYearSpace)
-	guesses := yearSpace * 365
+func (s Scorer) DateGuesses(m *match.Match) float64 {
+	// base guesses: (year distance from s.ReferenceYear) * num_days * num_years
+	guesses := float64(1)
+	if m.Year != 0 {
+		guesses = float64(mathutils.Max(mathutils.Abs(m.Year-s.ReferenceYear), MinYearSpace))
+	}
+	// textual and two-part dates may lack the year, the day or the month
+	// ("14feb", "march1987", "friday13", "2024-05")
+	switch {
+	case m.Week != 0:
+		// ISO 8601 week dates, maybe with the day of the week (Day, 1 to 7)
+		guesses *= 53
+		if m.Day != 0 {
+			guesses *= 7
+		}
+	case m.Month != 0 && m.Day != 0:
+		guesses *= 365
+	case m.Month != 0:
+		guesses *= 12
+	case m.Day != 0:
+		guesses *= 31
+	}
+	// month and weekday names are written in full or abbreviated, maybe capitalized
+	if m.MonthName != "" {
+		guesses *= 2 * UppercaseVariations(m.MonthName)
+	}
+	if m.Weekday != "" {
+		guesses *= 7 * 2 * UppercaseVariations(m.Weekday)
+	}
+	// "1st" vs "1"
+	if m.Ordinal {
+		guesses *= 2
+	}
+	// times of day: 24 hours * 60 minutes, maybe * 60 seconds
+	if m.TimeOfDay != "" {
+		guesses *= 24 * 60
+		if len(m.TimeOfDay) > len("hh:mm") {
+			guesses *= 60
+		}
+	}
 	// add factor of 4 for separator selection (one of ~4 choices)
 	if m.Separator != "" {
 		guesses *= 4
 	}
-	return float64(guesses)
+	return guesses
 }
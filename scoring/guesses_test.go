@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/trustelem/zxcvbn/adjacency"
 	"github.com/trustelem/zxcvbn/internal/mathutils"
 	"github.com/trustelem/zxcvbn/match"
@@ -25,9 +26,9 @@ func TestRepeatGuesses(t *testing.T) {
 		{"batterystaplebatterystaplebatterystaple", "batterystaple", 3},
 	}
 	for _, tt := range tests {
-		baseGuesses := scoring.MostGuessableMatchSequence(
+		baseGuesses := testScorer.MostGuessableMatchSequence(
 			tt.BaseToken,
-			matching.Omnimatch(tt.BaseToken, nil),
+			matching.Omnimatch(tt.BaseToken, nil, matching.Options{Scorer: testScorer}),
 			false,
 		).Guesses
 		match := &match.Match{
@@ -66,24 +67,24 @@ func TestSequenceGuesses(t *testing.T) {
 
 func TestRegexGuesses(t *testing.T) {
 	// guesses of 26^7 for 7-char lowercase regex
-	assert.Equal(t, math.Pow(26, 7), scoring.RegexGuesses(&match.Match{
+	assert.Equal(t, math.Pow(26, 7), testScorer.RegexGuesses(&match.Match{
 		Token:     "aizocdk",
 		RegexName: "alpha_lower",
 	}))
 
 	// guesses of 62^5 for 5-char alphanumeric regex
-	assert.Equal(t, math.Pow(2*26+10, 5), scoring.RegexGuesses(&match.Match{
+	assert.Equal(t, math.Pow(2*26+10, 5), testScorer.RegexGuesses(&match.Match{
 		Token:     "ag7C8",
 		RegexName: "alphanumeric",
 	}))
 
 	// "guesses of |year - REFERENCE_YEAR| for distant year matches"
-	assert.EqualValues(t, mathutils.Abs(scoring.ReferenceYear-1972), scoring.RegexGuesses(&match.Match{
+	assert.EqualValues(t, mathutils.Abs(testScorer.ReferenceYear-1972), testScorer.RegexGuesses(&match.Match{
 		Token:     "1972",
 		RegexName: "recent_year",
 	}))
 
-	assert.EqualValues(t, mathutils.Abs(scoring.MinYearSpace), scoring.RegexGuesses(&match.Match{
+	assert.EqualValues(t, mathutils.Abs(scoring.MinYearSpace), testScorer.RegexGuesses(&match.Match{
 		Token:     "2005",
 		RegexName: "recent_year",
 	}))
@@ -97,7 +98,7 @@ func TestDateGuesses(t *testing.T) {
 		Month: 1,
 		Day:   1,
 	}
-	assert.EqualValues(t, 365*mathutils.Abs(scoring.ReferenceYear-m.Year), scoring.DateGuesses(m))
+	assert.EqualValues(t, 365*mathutils.Abs(testScorer.ReferenceYear-m.Year), testScorer.DateGuesses(m))
 	// recent years assume MIN_YEAR_SPACE
 	// extra guesses are added for separators.
 	m = &match.Match{
@@ -107,7 +108,7 @@ func TestDateGuesses(t *testing.T) {
 		Day:       1,
 		Separator: "/",
 	}
-	assert.EqualValues(t, 365*scoring.MinYearSpace*4, scoring.DateGuesses(m))
+	assert.EqualValues(t, 365*scoring.MinYearSpace*4, testScorer.DateGuesses(m))
 
 }
 
@@ -168,6 +169,66 @@ func TestSpatialGuesses(t *testing.T) {
 	assert.Equal(t, guesses, scoring.SpatialGuesses(m))
 }
 
+func TestGraphSpatialGuesses(t *testing.T) {
+	macKeypad := adjacency.Graphs["mac_keypad"]
+	m := &match.Match{Pattern: "spatial", Token: "7410", Graph: "mac_keypad", Turns: 2}
+	guesses := float64(0)
+	for i := 2; i <= len(m.Token); i++ {
+		for j := 1; j <= m.Turns && j <= i-1; j++ {
+			guesses += mathutils.NCk(i-1, j-1) * float64(len(macKeypad.Graph)) * math.Pow(macKeypad.AverageDegree, float64(j))
+		}
+	}
+	graphGuesses, err := scoring.GraphSpatialGuesses(m)
+	require.NoError(t, err)
+	assert.Equal(t, guesses, graphGuesses)
+	// upstream prices mac_keypad walks with the keypad graph
+	assert.Less(t, scoring.SpatialGuesses(m), graphGuesses)
+	assert.Equal(t, graphGuesses, scoring.Scorer{GraphSpatialScoring: true}.EstimateGuesses(m, "7410"))
+
+	// qwerty and dvorak have the same number of keys and average degree
+	m = &match.Match{Pattern: "spatial", Token: "aoeu", Graph: "dvorak", Turns: 1}
+	graphGuesses, err = scoring.GraphSpatialGuesses(m)
+	require.NoError(t, err)
+	assert.Equal(t, scoring.SpatialGuesses(m), graphGuesses)
+
+	// unknown graphs are an error, and are priced as bruteforce by the scorer, which
+	// records it on the match
+	m = &match.Match{Pattern: "spatial", Token: "qwer", Graph: "colemak", Turns: 1}
+	_, err = scoring.GraphSpatialGuesses(m)
+	assert.ErrorIs(t, err, scoring.ErrUnknownGraph)
+	assert.False(t, m.UnknownGraph)
+	assert.Equal(t, scoring.BruteforceGuesses(m), scoring.Scorer{GraphSpatialScoring: true}.EstimateGuesses(m, "qwer"))
+	assert.True(t, m.UnknownGraph)
+	// known graphs are not flagged
+	m = &match.Match{Pattern: "spatial", Token: "aoeu", Graph: "dvorak", Turns: 1}
+	scoring.Scorer{GraphSpatialScoring: true}.EstimateGuesses(m, "aoeu")
+	assert.False(t, m.UnknownGraph)
+}
+
+func TestSpatialVariantGuesses(t *testing.T) {
+	walk := func(token string, turns int) float64 {
+		return scoring.SpatialGuesses(&match.Match{Token: token, Graph: "qwerty", Turns: turns})
+	}
+
+	// walks with gaps are guessed like walks as long, times the choice of the gap
+	m := &match.Match{Token: "qetu", Graph: "qwerty", Turns: 1, SpatialKind: "gap", Gap: 1}
+	assert.Equal(t, walk("qwer", 1)*2, scoring.SpatialGuesses(m))
+
+	// walks with doubled keys are guessed like the walk of their keys, times the repeat factor
+	m = &match.Match{Token: "qqwweerr", Graph: "qwerty", Turns: 1, SpatialKind: "doubled", KeyRepeat: 2}
+	assert.Equal(t, walk("qwer", 1)*2, scoring.SpatialGuesses(m))
+	m = &match.Match{Token: "QQWWEErr", Graph: "qwerty", Turns: 1, ShiftedCount: 6, SpatialKind: "doubled", KeyRepeat: 2}
+	// 3 of the 4 keys are shifted
+	assert.Equal(t, walk("qwer", 1)*mathutils.NCk(4, 1)*2, scoring.SpatialGuesses(m))
+
+	// walks switching graphs are guessed from their parts, doubled at each switch
+	qwe := &match.Match{Pattern: "spatial", Token: "qwe", Graph: "qwerty", Turns: 1}
+	keypad := &match.Match{Pattern: "spatial", Token: "789", Graph: "keypad", Turns: 1}
+	m = &match.Match{Token: "qwe789", Graph: "qwerty", Turns: 2, SpatialKind: "switch",
+		Graphs: []string{"qwerty", "keypad"}, BaseMatches: []*match.Match{qwe, keypad}}
+	assert.Equal(t, scoring.SpatialGuesses(qwe)*scoring.SpatialGuesses(keypad)*2, scoring.SpatialGuesses(m))
+}
+
 func TestDictionaryGuess(t *testing.T) {
 	// base guesses == the rank
 	assert.EqualValues(t, 32, scoring.DictionaryGuesses(&match.Match{
@@ -224,6 +285,9 @@ func TestUppercaseVariants(t *testing.T) {
 		{"ABCDEf", mathutils.NCk(6, 1)},
 		{"aBCDEf", mathutils.NCk(6, 1) + mathutils.NCk(6, 2)},
 		{"ABCdef", mathutils.NCk(6, 1) + mathutils.NCk(6, 2) + mathutils.NCk(6, 3)},
+		{"123", 1},
+		{"a1B2", mathutils.NCk(2, 1)},
+		{"1A1", 2},
 	}
 	for _, tt := range tests {
 		// check guess multiplier of word
@@ -231,6 +295,44 @@ func TestUppercaseVariants(t *testing.T) {
 	}
 }
 
+func TestUppercaseVariantsNonASCII(t *testing.T) {
+	tests := []struct {
+		Word     string
+		Variants float64
+	}{
+		// Latin extended
+		{"école", 1},
+		{"École", 2},
+		{"ÉCOLE", 2},
+		{"écolE", 2},
+		{"Straße", 2},
+		{"STRAßE", mathutils.NCk(6, 1)},
+		{"çaVa", mathutils.NCk(4, 1)},
+		{"ÉcOle", mathutils.NCk(5, 1) + mathutils.NCk(5, 2)},
+		{"ǅemal", 2}, // titlecase digraph
+		{"łÓdź", mathutils.NCk(4, 1)},
+		// Greek
+		{"αθήνα", 1},
+		{"Αθήνα", 2},
+		{"ΑΘΗΝΑ", 2},
+		{"αθηνΑ", 2},
+		{"αΘηΝα", mathutils.NCk(5, 1) + mathutils.NCk(5, 2)},
+		// Cyrillic
+		{"пароль", 1},
+		{"Пароль", 2},
+		{"ПАРОЛЬ", 2},
+		{"паролЬ", 2},
+		{"пАроль", mathutils.NCk(6, 1)},
+		{"ПАРОль", mathutils.NCk(6, 1) + mathutils.NCk(6, 2)},
+		// letters without case
+		{"密码", 1},
+		{"密码A", 2},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.Variants, scoring.UppercaseVariations(tt.Word), tt.Word)
+	}
+}
+
 func TestL33tVariants(t *testing.T) {
 	// 1 variant for non-l33t matches
 	assert.Equal(t, float64(1), scoring.L33tVariations(&match.Match{L33t: false}))
@@ -263,3 +365,236 @@ func TestL33tVariants(t *testing.T) {
 	variants := mathutils.NCk(6, 2) + mathutils.NCk(6, 1)
 	assert.Equal(t, variants, scoring.L33tVariations(m))
 }
+
+func TestMultiCharL33tVariants(t *testing.T) {
+	for _, tt := range []struct {
+		Word     string
+		Variants float64
+		Sub      map[string]string
+	}{
+		{"ph0ne", 4, map[string]string{"ph": "f", "0": "o"}},
+		{"|_|ber", 2, map[string]string{"|_|": "u"}},
+		{"|_|bu", mathutils.NCk(2, 1), map[string]string{"|_|": "u"}},
+		{"PHluff", mathutils.NCk(3, 1), map[string]string{"ph": "f"}},
+		// "|_|" isn't also counted as two "|"
+		{"|_|n|t", 4, map[string]string{"|_|": "u", "|": "i"}},
+		{"|_|n|ti", 2 * mathutils.NCk(2, 1), map[string]string{"|_|": "u", "|": "i"}},
+		// "ph" isn't counted as an unsubbed h
+		{"ph|-|", 4, map[string]string{"ph": "f", "|-|": "h"}},
+	} {
+		m := &match.Match{Token: tt.Word, Sub: tt.Sub, L33t: true}
+		assert.Equal(t, tt.Variants, scoring.L33tVariations(m), tt.Word)
+	}
+}
+
+func TestTextualDateGuesses(t *testing.T) {
+	// month and year: 12 months * year distance * full or abbreviated name
+	assert.EqualValues(t, 12*mathutils.Abs(testScorer.ReferenceYear-1987)*2, testScorer.DateGuesses(&match.Match{
+		Token:     "march1987",
+		Year:      1987,
+		Month:     3,
+		MonthName: "march",
+	}))
+	// day and month without a year, with a capitalized month name
+	assert.EqualValues(t, 365*2*2, testScorer.DateGuesses(&match.Match{
+		Token:     "Dec25",
+		Month:     12,
+		Day:       25,
+		MonthName: "Dec",
+	}))
+	// weekday and ordinal day
+	assert.EqualValues(t, 31*7*2*2, testScorer.DateGuesses(&match.Match{
+		Token:   "friday13th",
+		Day:     13,
+		Weekday: "friday",
+		Ordinal: true,
+	}))
+	// a textual date is cheaper than the same token as a dictionary word and a year
+	m := &match.Match{
+		Token:     "1st-march-1987",
+		Year:      1987,
+		Month:     3,
+		Day:       1,
+		MonthName: "march",
+		Ordinal:   true,
+		Separator: "-",
+	}
+	assert.EqualValues(t, 365*mathutils.Abs(testScorer.ReferenceYear-1987)*2*2*4, testScorer.DateGuesses(m))
+}
+
+func TestExtendedDateGuesses(t *testing.T) {
+	// year and month
+	assert.EqualValues(t, 12*scoring.MinYearSpace*4, testScorer.DateGuesses(&match.Match{
+		Token:     "2018-05",
+		Year:      2018,
+		Month:     5,
+		Separator: "-",
+	}))
+	// time of day
+	assert.EqualValues(t, 24*60, testScorer.DateGuesses(&match.Match{
+		Token:     "1230",
+		TimeOfDay: "12:30",
+	}))
+	assert.EqualValues(t, 24*60*60*4, testScorer.DateGuesses(&match.Match{
+		Token:     "23:59:59",
+		TimeOfDay: "23:59:59",
+		Separator: ":",
+	}))
+	// ISO 8601 week date, with the day of the week
+	assert.EqualValues(t, scoring.MinYearSpace*53*7, testScorer.DateGuesses(&match.Match{
+		Token: "2018W183",
+		Year:  2018,
+		Week:  18,
+		Day:   3,
+	}))
+	// ISO 8601 date-time
+	assert.EqualValues(t, scoring.MinYearSpace*365*24*60, testScorer.DateGuesses(&match.Match{
+		Token:     "20180501T1200",
+		Year:      2018,
+		Month:     5,
+		Day:       1,
+		TimeOfDay: "12:00",
+	}))
+}
+
+func TestNonASCIIDigitGuesses(t *testing.T) {
+	// non-ASCII digits are scored as their ASCII counterparts
+	assert.Equal(t,
+		scoring.SequenceGuesses(&match.Match{Token: "1234", Ascending: true}),
+		scoring.SequenceGuesses(&match.Match{Token: "١٢٣٤", Ascending: true}))
+	assert.Equal(t,
+		testScorer.RegexGuesses(&match.Match{Token: "1972", RegexName: "recent_year"}),
+		testScorer.RegexGuesses(&match.Match{Token: "１９７２", RegexName: "recent_year"}))
+}
+
+func TestFoldVariations(t *testing.T) {
+	// 1 variant for unfolded matches
+	assert.Equal(t, float64(1), scoring.FoldVariations(&match.Match{Token: "password"}))
+
+	for _, tt := range []struct {
+		Word     string
+		Variants float64
+		Folds    map[string]string
+	}{
+		{"pässwörd", 4, map[string]string{"ä": "a", "ö": "o"}},
+		{"passwоrd", 2, map[string]string{"о": "o"}},
+		{"bööboo", mathutils.NCk(4, 1) + mathutils.NCk(4, 2), map[string]string{"ö": "o"}},
+		{"BÖÖBOO", mathutils.NCk(4, 1) + mathutils.NCk(4, 2), map[string]string{"ö": "o"}},
+		// a removed combining mark is priced like a precomposed letter
+		{"pa\u0308ssword", 2, map[string]string{"\u0308": ""}},
+	} {
+		m := &match.Match{Token: tt.Word, Folded: true, Folds: tt.Folds}
+		assert.Equal(t, tt.Variants, scoring.FoldVariations(m), tt.Word)
+	}
+
+	// fold variations are part of dictionary guesses
+	m := &match.Match{Token: "pässwörd", Rank: 2, Folded: true, Folds: map[string]string{"ä": "a", "ö": "o"}}
+	assert.EqualValues(t, 2*4, scoring.DictionaryGuesses(m))
+	assert.EqualValues(t, 4, m.FoldVariations)
+}
+
+func TestEditVariations(t *testing.T) {
+	// 1 variant for matches without typos
+	assert.Equal(t, float64(1), scoring.EditVariations(&match.Match{MatchedWord: "password"}))
+
+	// a word of 8 letters has 8 deletions, 7 transpositions, 25*8 substitutions and 26*9 insertions
+	m := &match.Match{
+		Token:       "pasword",
+		MatchedWord: "password",
+		Rank:        2,
+		Edits:       []match.Edit{{Operation: "deletion", Position: 2, From: "s"}},
+	}
+	assert.Equal(t, float64(449), scoring.EditVariations(m))
+	assert.Equal(t, float64(2*449), scoring.DictionaryGuesses(m))
+	assert.Equal(t, float64(449), m.EditVariations)
+
+	// typos can be made in any order
+	m.Edits = append(m.Edits, match.Edit{Operation: "substitution", Position: 4, From: "o", To: "i"})
+	assert.Equal(t, 449.0*449/2, scoring.EditVariations(m))
+}
+
+func TestAbbreviationVariations(t *testing.T) {
+	// 1 variant for words that are not abbreviated
+	assert.Equal(t, float64(1), scoring.AbbreviationVariations(&match.Match{MatchedWord: "dragon"}))
+
+	// the word is abbreviated or not, and "drgn" stands for 3 words
+	m := &match.Match{
+		Token:        "drgn",
+		MatchedWord:  "dragon",
+		Rank:         10,
+		Abbreviation: "vowels_dropped",
+		Ambiguity:    3,
+	}
+	assert.Equal(t, float64(6), scoring.AbbreviationVariations(m))
+	assert.Equal(t, float64(60), scoring.DictionaryGuesses(m))
+	assert.Equal(t, float64(6), m.AbbreviationVariations)
+
+	m = &match.Match{Token: "luv", MatchedWord: "love", Rank: 10, Abbreviation: "phonetic", Ambiguity: 1}
+	assert.Equal(t, float64(2), scoring.AbbreviationVariations(m))
+}
+
+func TestKeyboardLayoutGuesses(t *testing.T) {
+	m := &match.Match{
+		Pattern:      "keyboard_layout",
+		Token:        "Ghbdtn",
+		MatchedWord:  "привет",
+		Rank:         10,
+		SourceLayout: "qwerty",
+		TargetLayout: "russian",
+		LayoutPairs:  3,
+	}
+	// 3 pairs of layouts tried both ways, and the first letter is uppercase
+	assert.Equal(t, float64(6), scoring.LayoutVariations(m))
+	assert.Equal(t, float64(10*2*6), scoring.KeyboardLayoutGuesses(m))
+	assert.Equal(t, float64(6), m.LayoutVariations)
+	assert.Equal(t, float64(2), m.UppercaseVariations)
+}
+
+func TestT9Guesses(t *testing.T) {
+	// "7277" types "pass", and 3 other words of the dictionary
+	m := &match.Match{Pattern: "t9", Token: "7277", MatchedWord: "pass", Rank: 10, Ambiguity: 4}
+	assert.Equal(t, float64(40), scoring.T9Guesses(m))
+	assert.Equal(t, float64(10), m.BaseGuesses)
+}
+
+func TestPassphraseGuesses(t *testing.T) {
+	m := &match.Match{
+		Pattern:   "passphrase",
+		Token:     "Correct-horse-battery-staple",
+		Words:     []string{"correct", "horse", "battery", "staple"},
+		Separator: "-",
+		Rank:      7776,
+	}
+	separators := float64(len(scoring.Separators))
+	assert.Equal(t, 7776.0*7776*7776*7776*separators*2, scoring.PassphraseGuesses(m))
+	assert.Equal(t, float64(2), m.UppercaseVariations)
+}
+
+func TestSeparatedGuesses(t *testing.T) {
+	m := &match.Match{Pattern: "separated", Token: "john_smith_1985", Separator: "_", BaseGuesses: 1000}
+	// the separator is paid for once
+	assert.Equal(t, 1000*float64(len(scoring.Separators)), scoring.SeparatedGuesses(m))
+}
+
+func TestPalindromeGuesses(t *testing.T) {
+	m := &match.Match{Pattern: "palindrome", Token: "abccba", BaseToken: "abc", BaseGuesses: 13}
+	assert.Equal(t, float64(13*2), scoring.PalindromeGuesses(m))
+	m = &match.Match{Pattern: "palindrome", Token: "1234321", BaseToken: "123", BaseGuesses: 13, Pivot: "4"}
+	assert.Equal(t, float64(13*2*scoring.MinSubmatchGuessesSingleChar), scoring.PalindromeGuesses(m))
+}
+
+func TestRepeatVariations(t *testing.T) {
+	m := &match.Match{Pattern: "repeat", Token: "abcabc", BaseToken: "abc", BaseGuesses: 13, RepeatCount: 2}
+	assert.Equal(t, float64(13*2), scoring.RepeatGuesses(m))
+	assert.Equal(t, float64(0), m.RepeatVariations)
+
+	m = &match.Match{Pattern: "repeat", Token: "abcABC", BaseToken: "abc", BaseGuesses: 13, RepeatCount: 2,
+		RepeatVariants: []match.RepeatVariant{{Token: "ABC", Case: true}}}
+	assert.Equal(t, float64(13*2*2), scoring.RepeatGuesses(m))
+	assert.Equal(t, float64(2), m.RepeatVariations)
+
+	m = &match.Match{Pattern: "repeat", Token: "dogg0d", BaseToken: "dog", BaseGuesses: 13, RepeatCount: 2,
+		RepeatVariants: []match.RepeatVariant{{Token: "g0d", L33tSubs: 1, Reversed: true}}}
+	assert.Equal(t, float64(13*2*4), scoring.RepeatGuesses(m))
+	assert.Equal(t, float64(4), m.RepeatVariations)
+}
@@ -0,0 +1,361 @@
+package scoring
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/trustelem/zxcvbn/frequency"
+	"github.com/trustelem/zxcvbn/internal/mathutils"
+	"github.com/trustelem/zxcvbn/match"
+)
+
+// MarkovModel is a character n-gram model: the probability of each character of a
+// token depends on the order characters before it. It prices bruteforce segments by
+// their probability, instead of BruteforceCardinality guesses per character, so that
+// pronounceable segments like "glimpor" take fewer guesses than random ones like "qxjzvkw".
+//
+// Probabilities are smoothed with Witten-Bell interpolation between the orders, down
+// to the frequency of single characters, which is smoothed with add-one over the
+// characters seen in training and an unknown one. Characters are lowercased.
+type MarkovModel struct {
+	order int
+	// grams counts each character after each context of 0 to order characters, keyed
+	// by context and character; contexts count the characters after each context, and
+	// followers the different ones.
+	grams     map[string]int
+	contexts  map[string]int
+	followers map[string]int
+}
+
+const (
+	// DefaultMarkovOrder is the order of the default model: characters depend on the 2
+	// characters before them.
+	DefaultMarkovOrder = 2
+	// maxMarkovOrder bounds the order of models.
+	maxMarkovOrder = 8
+	// MarkovFallbackCardinality is the number of printable ASCII characters, tried for
+	// each character of tokens the model finds unlikely.
+	MarkovFallbackCardinality = 95
+	// markovStart pads the context of the first characters of a token.
+	markovStart = '\x00'
+)
+
+// NewMarkovModel returns an empty model of the given order, from 0 to 8.
+func NewMarkovModel(order int) (*MarkovModel, error) {
+	if order < 0 || order > maxMarkovOrder {
+		return nil, fmt.Errorf("markov model: invalid order %d", order)
+	}
+	return &MarkovModel{
+		order:     order,
+		grams:     make(map[string]int),
+		contexts:  make(map[string]int),
+		followers: make(map[string]int),
+	}, nil
+}
+
+// Order returns the number of characters each character depends on.
+func (mm *MarkovModel) Order() int {
+	return mm.order
+}
+
+// Train adds count occurrences of word to the model.
+func (mm *MarkovModel) Train(word string, count int) {
+	if count <= 0 {
+		return
+	}
+	context := []rune(strings.Repeat(string(markovStart), mm.order))
+	for _, c := range strings.ToLower(word) {
+		mm.add(context, c, count)
+		if mm.order > 0 {
+			context = append(context[1:], c)
+		}
+	}
+}
+
+// add counts count occurrences of c after context, a full-order context, and after
+// each of its suffixes.
+func (mm *MarkovModel) add(context []rune, c rune, count int) {
+	for k := 0; k <= len(context); k++ {
+		ctx := string(context[len(context)-k:])
+		gram := ctx + string(c)
+		if mm.grams[gram] == 0 {
+			mm.followers[ctx]++
+		}
+		mm.grams[gram] += count
+		mm.contexts[ctx] += count
+	}
+}
+
+// Probability returns the probability of token, which is lowercased.
+func (mm *MarkovModel) Probability(token string) float64 {
+	return math.Exp(mm.logProbability(strings.ToLower(token)))
+}
+
+func (mm *MarkovModel) logProbability(token string) float64 {
+	logP := float64(0)
+	context := []rune(strings.Repeat(string(markovStart), mm.order))
+	for _, c := range token {
+		logP += math.Log(mm.conditional(context, c))
+		if mm.order > 0 {
+			context = append(context[1:], c)
+		}
+	}
+	return logP
+}
+
+// conditional returns the probability of c after context.
+func (mm *MarkovModel) conditional(context []rune, c rune) float64 {
+	// single characters, with add-one smoothing over the characters seen and an unknown one
+	p := float64(mm.grams[string(c)]+1) / float64(mm.contexts[""]+mm.followers[""]+1)
+	for k := 1; k <= len(context); k++ {
+		ctx := string(context[len(context)-k:])
+		n := mm.contexts[ctx]
+		if n == 0 {
+			break
+		}
+		// Witten-Bell: the weight of lower orders is the share of new characters after ctx
+		t := float64(mm.followers[ctx])
+		p = (float64(mm.grams[ctx+string(c)]) + t*p) / (float64(n) + t)
+	}
+	return p
+}
+
+// Guesses estimates the guesses of a bruteforce token with the model: the inverse of
+// its probability, times its uppercase variations. Characters the model has hardly
+// seen, such as symbols in a model trained on words, would take more guesses than
+// trying every printable character: tokens never take more than
+// MarkovFallbackCardinality guesses per character.
+func (mm *MarkovModel) Guesses(m *match.Match) float64 {
+	return mm.guesses(m, mm.logProbability(strings.ToLower(m.Token)), UppercaseVariations(m.Token))
+}
+
+// guesses prices m given the log-probability and the uppercase variations of its token.
+func (mm *MarkovModel) guesses(m *match.Match, logP, variations float64) float64 {
+	guesses := math.Exp(-logP) * variations
+	fallback := math.Pow(MarkovFallbackCardinality, float64(utf8.RuneCountInString(m.Token)))
+	// like bruteforce guesses, leave room for other submatches of the same token
+	return math.Max(math.Min(guesses, fallback), bruteforceMinGuesses(m))
+}
+
+// markovSegments holds the log-probabilities and the case of the characters of a
+// password, so that the guesses of any segment of it are found in constant time instead
+// of scoring the segment character by character: MostGuessableMatchSequence prices
+// every bruteforce segment it tries.
+type markovSegments struct {
+	password string
+	order    int
+	// runeIndex maps the byte index of each character of password, and len(password),
+	// to the index of the character, and other byte indexes to -1.
+	runeIndex []int
+	// full[k] sums the log-probabilities of the characters before the k-th one, each
+	// after the order characters before it.
+	full []float64
+	// head[k][t] sums the log-probabilities of the t characters from the k-th one, in a
+	// segment starting at the k-th one: their context is padded like a token's start.
+	head [][]float64
+	// upper[k] and lower[k] count the uppercase and lowercase letters before the k-th
+	// character, and isUpper[k] tells whether it is an uppercase letter.
+	upper, lower []int
+	isUpper      []bool
+	// binomialSums[n][k] caches binomialSum(n, k), a row at a time.
+	binomialSums [][]float64
+}
+
+// segments returns the log-probabilities and the case of the characters of password.
+func (mm *MarkovModel) segments(password string) *markovSegments {
+	runes := []rune(password)
+	lowered := []rune(strings.ToLower(password))
+	ms := &markovSegments{
+		password:     password,
+		order:        mm.order,
+		runeIndex:    make([]int, len(password)+1),
+		full:         make([]float64, len(runes)+1),
+		head:         make([][]float64, len(runes)),
+		upper:        make([]int, len(runes)+1),
+		lower:        make([]int, len(runes)+1),
+		isUpper:      make([]bool, len(runes)),
+		binomialSums: make([][]float64, len(runes)+1),
+	}
+	for i := range ms.runeIndex {
+		ms.runeIndex[i] = -1
+	}
+	k := 0
+	for i := range password {
+		ms.runeIndex[i] = k
+		k++
+	}
+	ms.runeIndex[len(password)] = len(runes)
+
+	padded := append([]rune(strings.Repeat(string(markovStart), mm.order)), lowered...)
+	for k, c := range lowered {
+		ms.full[k+1] = ms.full[k] + math.Log(mm.conditional(padded[k:k+mm.order], c))
+
+		// the context of the t-th character of a segment starting at k is padded
+		// with the order-t characters of markovStart
+		head := make([]float64, mathutils.Min(mm.order, len(lowered)-k)+1)
+		context := padded[:mm.order:mm.order]
+		for t := 1; t < len(head); t++ {
+			head[t] = head[t-1] + math.Log(mm.conditional(context, lowered[k+t-1]))
+			if mm.order > 0 {
+				context = append(context[1:], lowered[k+t-1])
+			}
+		}
+		ms.head[k] = head
+
+		ms.isUpper[k] = isUpper(runes[k])
+		ms.upper[k+1], ms.lower[k+1] = ms.upper[k], ms.lower[k]
+		if ms.isUpper[k] {
+			ms.upper[k+1]++
+		} else if unicode.IsLower(runes[k]) {
+			ms.lower[k+1]++
+		}
+	}
+	return ms
+}
+
+// segment returns the log-probability and the uppercase variations of the token of m,
+// a segment of the password made of whole characters, and false if m is not one or ms
+// is nil.
+func (ms *markovSegments) segment(m *match.Match) (logP, variations float64, ok bool) {
+	if ms == nil || m.I < 0 || m.J >= len(ms.password) || m.I > m.J || ms.password[m.I:m.J+1] != m.Token {
+		return 0, 0, false
+	}
+	i, j := ms.runeIndex[m.I], ms.runeIndex[m.J+1]
+	if i < 0 || j < 0 {
+		return 0, 0, false
+	}
+	t := mathutils.Min(ms.order, j-i)
+	logP = ms.head[i][t] + ms.full[j] - ms.full[i+t]
+	variations = caseVariations(ms.upper[j]-ms.upper[i], ms.lower[j]-ms.lower[i], j-i,
+		ms.isUpper[i], ms.isUpper[j-1], ms.binomialSum)
+	return logP, variations, true
+}
+
+// binomialSum returns binomialSum(n, k), computing the sums of NCk(n, i) for every k
+// the first time n is asked for.
+func (ms *markovSegments) binomialSum(n, k int) float64 {
+	if ms.binomialSums[n] == nil {
+		// NCk(n, i) is NCk(n, i-1) * (n-i+1) / i, as NCk computes it
+		sums := make([]float64, n/2+1)
+		c := float64(1)
+		for i := 1; i < len(sums); i++ {
+			c *= float64(n - i + 1)
+			c /= float64(i)
+			sums[i] = sums[i-1] + c
+		}
+		ms.binomialSums[n] = sums
+	}
+	return ms.binomialSums[n][k]
+}
+
+// markovMagic starts serialised models, followed by the version of the format.
+const markovMagic = "ZXMM"
+
+// MarshalBinary serialises the model: after a header with its order, the counts of
+// characters after full-order contexts, sorted, with runes and counts as varints. The
+// counts of lower orders are derived from them when the model is read back.
+func (mm *MarkovModel) MarshalBinary() ([]byte, error) {
+	var grams []string
+	for gram := range mm.grams {
+		if utf8.RuneCountInString(gram) == mm.order+1 {
+			grams = append(grams, gram)
+		}
+	}
+	sort.Strings(grams)
+
+	var b bytes.Buffer
+	b.WriteString(markovMagic)
+	b.WriteByte(1)
+	buf := make([]byte, binary.MaxVarintLen64)
+	writeUvarint := func(x uint64) {
+		b.Write(buf[:binary.PutUvarint(buf, x)])
+	}
+	writeUvarint(uint64(mm.order))
+	writeUvarint(uint64(len(grams)))
+	for _, gram := range grams {
+		for _, r := range gram {
+			writeUvarint(uint64(r))
+		}
+		writeUvarint(uint64(mm.grams[gram]))
+	}
+	return b.Bytes(), nil
+}
+
+// ErrInvalidMarkovModel is returned when reading a serialised model fails.
+var ErrInvalidMarkovModel = errors.New("markov model: invalid serialised model")
+
+// UnmarshalBinary reads a model serialised by MarshalBinary.
+func (mm *MarkovModel) UnmarshalBinary(data []byte) error {
+	r := bytes.NewReader(data)
+	header := make([]byte, len(markovMagic)+1)
+	if _, err := io.ReadFull(r, header); err != nil || string(header[:len(markovMagic)]) != markovMagic || header[len(markovMagic)] != 1 {
+		return ErrInvalidMarkovModel
+	}
+	order, err := binary.ReadUvarint(r)
+	if err != nil || order > maxMarkovOrder {
+		return ErrInvalidMarkovModel
+	}
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return ErrInvalidMarkovModel
+	}
+	model, _ := NewMarkovModel(int(order))
+	context := make([]rune, order)
+	for ; n > 0; n-- {
+		for k := range context {
+			if context[k], err = readRune(r); err != nil {
+				return ErrInvalidMarkovModel
+			}
+		}
+		c, err := readRune(r)
+		if err != nil {
+			return ErrInvalidMarkovModel
+		}
+		count, err := binary.ReadUvarint(r)
+		if err != nil || count == 0 || count > uint64(^uint(0)>>1) {
+			return ErrInvalidMarkovModel
+		}
+		model.add(context, c, int(count))
+	}
+	if _, err := r.ReadByte(); err != io.EOF {
+		return ErrInvalidMarkovModel
+	}
+	*mm = *model
+	return nil
+}
+
+func readRune(r io.ByteReader) (rune, error) {
+	x, err := binary.ReadUvarint(r)
+	if err != nil || x > utf8.MaxRune {
+		return 0, ErrInvalidMarkovModel
+	}
+	return rune(x), nil
+}
+
+var (
+	defaultMarkovOnce  sync.Once
+	defaultMarkovModel *MarkovModel
+)
+
+// DefaultMarkovModel returns the model of order DefaultMarkovOrder trained on the words
+// of the frequency lists, each counted once. It is trained on first use.
+func DefaultMarkovModel() *MarkovModel {
+	defaultMarkovOnce.Do(func() {
+		defaultMarkovModel, _ = NewMarkovModel(DefaultMarkovOrder)
+		for _, words := range frequency.FrequencyLists {
+			for _, word := range words {
+				defaultMarkovModel.Train(word, 1)
+			}
+		}
+	})
+	return defaultMarkovModel
+}
@@ -0,0 +1,135 @@
+package scoring_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/trustelem/zxcvbn/match"
+	"github.com/trustelem/zxcvbn/scoring"
+)
+
+func trainedMarkovModel(t *testing.T, order int) *scoring.MarkovModel {
+	mm, err := scoring.NewMarkovModel(order)
+	require.NoError(t, err)
+	for _, word := range []string{"banana", "bandana", "Anna", "nab"} {
+		mm.Train(word, 2)
+	}
+	mm.Train("cab", 1)
+	return mm
+}
+
+func TestMarkovModel(t *testing.T) {
+	_, err := scoring.NewMarkovModel(-1)
+	assert.Error(t, err)
+	_, err = scoring.NewMarkovModel(9)
+	assert.Error(t, err)
+
+	for order := 0; order <= 3; order++ {
+		mm := trainedMarkovModel(t, order)
+		assert.Equal(t, order, mm.Order())
+		// the probabilities of the next character add up to 1, with the unknown ones
+		for _, prefix := range []string{"", "b", "ba", "ban", "xyz"} {
+			sum := float64(0)
+			// "z" stands for the characters the model has never seen
+			for _, c := range "abcdnz" {
+				sum += mm.Probability(prefix+string(c)) / mm.Probability(prefix)
+			}
+			assert.InDelta(t, 1, sum, 1e-9, "order %d, after %q", order, prefix)
+		}
+		// case doesn't matter
+		assert.Equal(t, mm.Probability("banana"), mm.Probability("BaNaNa"))
+	}
+
+	mm := trainedMarkovModel(t, 2)
+	assert.Greater(t, mm.Probability("banana"), mm.Probability("nbaana"))
+	assert.Greater(t, mm.Probability("nab"), mm.Probability("cab"))
+}
+
+func TestMarkovGuesses(t *testing.T) {
+	mm := scoring.DefaultMarkovModel()
+	guesses := func(token string) float64 {
+		return mm.Guesses(&match.Match{Pattern: "bruteforce", Token: token})
+	}
+	// pronounceable tokens take fewer guesses than random ones
+	assert.Less(t, guesses("tianya"), guesses("xqzvjk"))
+	assert.Less(t, guesses("ing"), scoring.BruteforceGuesses(&match.Match{Token: "ing"}))
+	// with uppercase variations
+	assert.Equal(t, 2*guesses("tianya"), guesses("Tianya"))
+	// at most the guesses of trying every printable character
+	assert.Equal(t, math.Pow(scoring.MarkovFallbackCardinality, 2), guesses("&}"))
+	// at least the guesses of bruteforce submatches
+	aaa, err := scoring.NewMarkovModel(0)
+	require.NoError(t, err)
+	aaa.Train("aaaa", 1000)
+	assert.Equal(t, float64(scoring.MinSubmatchGuessesSingleChar+1), aaa.Guesses(&match.Match{Token: "a"}))
+	assert.Equal(t, float64(scoring.MinSubmatchGuessesMultiChar+1), aaa.Guesses(&match.Match{Token: "aa"}))
+
+	scorer := scoring.Scorer{ReferenceYear: 2019, Markov: mm}
+	m := &match.Match{Pattern: "bruteforce", I: 0, J: 5, Token: "tianya"}
+	assert.Equal(t, guesses("tianya"), scorer.EstimateGuesses(m, "tianya"))
+}
+
+func TestMarkovSequenceGuesses(t *testing.T) {
+	// the bruteforce segments of a sequence are priced as tokens of their own, from
+	// log-probabilities computed once per password
+	for order := 0; order <= 3; order++ {
+		mm := trainedMarkovModel(t, order)
+		scorer := scoring.Scorer{ReferenceYear: 2019, Markov: mm}
+		for _, password := range []string{"b", "Banana", "nabbanana", "nabAnna€nab!", "ab€€bandanab", "nabBaNanAnDAnab"} {
+			var matches []*match.Match
+			for i := 0; i+3 <= len(password); i++ {
+				if password[i:i+3] == "nab" {
+					matches = append(matches, &match.Match{Pattern: "dictionary", I: i, J: i + 2, Token: "nab", Rank: 1})
+				}
+			}
+			result := scorer.MostGuessableMatchSequence(password, matches, false)
+			for _, m := range result.Sequence {
+				if m.Pattern == "bruteforce" {
+					expected := mm.Guesses(&match.Match{Pattern: "bruteforce", Token: m.Token})
+					assert.InEpsilon(t, expected, m.Guesses, 1e-9, "%s in %s", m.Token, password)
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkMarkovSequenceGuesses(b *testing.B) {
+	scorer := scoring.Scorer{ReferenceYear: 2019, Markov: scoring.DefaultMarkovModel()}
+	password := strings.Repeat("abAB", 50)
+	// bruteforce segments are tried between every pair of matches
+	var matches []*match.Match
+	for i := 0; i < len(password); i += 4 {
+		matches = append(matches, &match.Match{Pattern: "dictionary", I: i, J: i + 1, Token: "ab", Rank: 1})
+	}
+	for n := 0; n < b.N; n++ {
+		for _, m := range matches {
+			m.Guesses = 0
+		}
+		scorer.MostGuessableMatchSequence(password, matches, false)
+	}
+}
+
+func TestMarkovModelSerialisation(t *testing.T) {
+	for order := 0; order <= 3; order++ {
+		mm := trainedMarkovModel(t, order)
+		data, err := mm.MarshalBinary()
+		require.NoError(t, err)
+
+		var read scoring.MarkovModel
+		require.NoError(t, read.UnmarshalBinary(data))
+		assert.Equal(t, order, read.Order())
+		for _, token := range []string{"banana", "nab", "xyz", ""} {
+			assert.Equal(t, mm.Probability(token), read.Probability(token), token)
+		}
+		again, err := read.MarshalBinary()
+		require.NoError(t, err)
+		assert.Equal(t, data, again)
+
+		for _, corrupted := range [][]byte{nil, data[:len(data)-1], append(append([]byte{}, data...), 0), append([]byte("ZXMX"), data[4:]...)} {
+			assert.ErrorIs(t, read.UnmarshalBinary(corrupted), scoring.ErrInvalidMarkovModel)
+		}
+	}
+}
@@ -8,6 +8,33 @@ import (
 	"github.com/trustelem/zxcvbn/match"
 )
 
+// Scorer estimates the number of guesses needed to crack a password from its matches.
+// Dates and years are priced by their distance to ReferenceYear, which is why it is
+// part of the Scorer rather than read from the clock: concurrent evaluations can use
+// different reference years, and a fixed one gives reproducible results.
+//
+// GraphSpatialScoring prices keyboard walks with the size and average degree of the
+// adjacency graph they were typed on. Without it, walks are priced as upstream zxcvbn
+// 4.4.2 does, with the qwerty graph for qwerty and dvorak and the keypad graph for any
+// other graph. Walks on graphs missing from adjacency.Graphs can't be priced with their
+// graph: they are priced as bruteforce and have match.Match.UnknownGraph set.
+//
+// Markov, if set, prices the bruteforce segments of passwords by their probability in a
+// character n-gram model instead of BruteforceCardinality guesses per character.
+//
+// CharacterClassCardinality prices the bruteforce segments of passwords with the
+// cardinality of the character classes present in them, see ClassBruteforceGuesses. A
+// Markov model takes precedence.
+type Scorer struct {
+	ReferenceYear             int
+	GraphSpatialScoring       bool
+	Markov                    *MarkovModel
+	CharacterClassCardinality bool
+
+	// markovSegments caches the log-probabilities of the password being scored.
+	markovSegments *markovSegments
+}
+
 type Result struct {
 	Password string
 	Guesses  float64
@@ -45,8 +72,11 @@ type Result struct {
 //    sequences before length-3. assuming at minimum D guesses per pattern type,
 //    D^(l-1) approximates Sum(D^i for i in [1..l-1]
 //
-func MostGuessableMatchSequence(password string, matches []*match.Match, excludeAdditive bool) (result Result) {
+func (s Scorer) MostGuessableMatchSequence(password string, matches []*match.Match, excludeAdditive bool) (result Result) {
 	n := len(password)
+	if s.Markov != nil {
+		s.markovSegments = s.Markov.segments(password)
+	}
 	validIndexes := make([]bool, n)
 	for i := range password {
 		validIndexes[i] = true
@@ -102,7 +132,7 @@ func MostGuessableMatchSequence(password string, matches []*match.Match, exclude
 	// than previously encountered sequences, updating state if so.
 	update := func(m *match.Match, l int) {
 		k := m.J
-		pi := EstimateGuesses(m, password)
+		pi := s.EstimateGuesses(m, password)
 		if l > 1 {
 			// we're considering a length-l sequence ending with match m:
 			// obtain the product term in the minimization function by multiplying m's guesses
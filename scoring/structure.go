@@ -0,0 +1,163 @@
+package scoring
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/trustelem/zxcvbn/frequency"
+	"github.com/trustelem/zxcvbn/match"
+)
+
+// StructureModel assigns probabilities to the base structures of passwords, as the
+// probabilistic context-free grammars of password crackers do: crackers try the
+// structures of their training corpus in order of probability, filling in each
+// segment with its likeliest values, so passwords with a common shape are found
+// earlier than the sequences of MostGuessableMatchSequence suggest.
+//
+// A base structure is the sequence of runs of letters (L), digits (D) and symbols (S)
+// of a password, with their lengths: "Password12!" is L8D2S1.
+type StructureModel struct {
+	counts map[string]float64
+	total  float64
+}
+
+// NewStructureModel returns the model of structures counted in counts.
+func NewStructureModel(counts map[string]int) *StructureModel {
+	sm := &StructureModel{counts: make(map[string]float64, len(counts))}
+	for structure, count := range counts {
+		if count > 0 {
+			sm.counts[structure] = float64(count)
+			sm.total += float64(count)
+		}
+	}
+	return sm
+}
+
+// LearnStructureModel returns the model of the structures of the passwords of a corpus.
+func LearnStructureModel(passwords []string) *StructureModel {
+	counts := make(map[string]int)
+	for _, password := range passwords {
+		if password != "" {
+			counts[Structure(password)]++
+		}
+	}
+	return NewStructureModel(counts)
+}
+
+// DefaultStructureModel is learned from the passwords frequency list.
+var DefaultStructureModel = NewStructureModel(frequency.Structures)
+
+// Probability returns the probability of structure, 0 for structures the model has
+// never seen.
+func (sm *StructureModel) Probability(structure string) float64 {
+	if sm.total == 0 {
+		return 0
+	}
+	return sm.counts[structure] / sm.total
+}
+
+// Structure returns the base structure of password: "Password12!" is L8D2S1.
+func Structure(password string) string {
+	var b strings.Builder
+	for _, s := range structureSegments(password) {
+		b.WriteByte(s.class)
+		b.WriteString(strconv.Itoa(s.length))
+	}
+	return b.String()
+}
+
+// structureSegment is a run of characters of the same class, password[i:j].
+type structureSegment struct {
+	i, j   int
+	class  byte
+	length int
+}
+
+func structureSegments(password string) []structureSegment {
+	var segments []structureSegment
+	for i, r := range password {
+		class := characterClass(r)
+		if n := len(segments); n > 0 && segments[n-1].class == class {
+			segments[n-1].j = i + utf8.RuneLen(r)
+			segments[n-1].length++
+			continue
+		}
+		segments = append(segments, structureSegment{i: i, j: i + utf8.RuneLen(r), class: class, length: 1})
+	}
+	return segments
+}
+
+func characterClass(r rune) byte {
+	switch {
+	case unicode.IsLetter(r):
+		return 'L'
+	case unicode.IsDigit(r):
+		return 'D'
+	default:
+		return 'S'
+	}
+}
+
+// StructureResult is the estimate of the guesses of a password by a cracker trying the
+// base structures of a StructureModel.
+type StructureResult struct {
+	Structure   string
+	Probability float64
+	// Guesses is +Inf for structures the model has never seen.
+	Guesses float64
+	// Sequence is the concatenation of the most guessable match sequences of the
+	// segments of the structure.
+	Sequence []*match.Match
+}
+
+// MostGuessableStructure estimates the guesses of password for a cracker trying the
+// base structures of model in order of probability: about 1/p guesses for a password
+// of probability p, the probability of its structure times those of its segments.
+// Segments are scored on their own, with MostGuessableMatchSequence and the matches
+// of password that fit in them, and a segment taking g guesses has a probability of 1/g.
+//
+// It is an optional component, to weigh against MostGuessableMatchSequence: the lower
+// estimate of both wins.
+func (s Scorer) MostGuessableStructure(password string, matches []*match.Match, model *StructureModel) StructureResult {
+	result := StructureResult{
+		Structure: Structure(password),
+		Guesses:   math.Inf(1),
+	}
+	result.Probability = model.Probability(result.Structure)
+	if result.Probability == 0 {
+		return result
+	}
+
+	guesses := 1 / result.Probability
+	for _, segment := range structureSegments(password) {
+		token := password[segment.i:segment.j]
+		var segmentMatches []*match.Match
+		for _, m := range matches {
+			if m.I >= segment.i && m.J < segment.j {
+				// the guesses of matches depend on the password they are found in
+				sm := *m
+				sm.I -= segment.i
+				sm.J -= segment.i
+				sm.Guesses = 0
+				segmentMatches = append(segmentMatches, &sm)
+			}
+		}
+		r := s.MostGuessableMatchSequence(token, segmentMatches, false)
+		segmentGuesses := r.Guesses
+		if segmentGuesses < 1 {
+			// no sequence spans the segment
+			segmentGuesses = s.bruteforceGuesses(&match.Match{Pattern: "bruteforce", Token: token})
+		}
+		guesses *= segmentGuesses
+		for _, m := range r.Sequence {
+			m.I += segment.i
+			m.J += segment.i
+			result.Sequence = append(result.Sequence, m)
+		}
+	}
+	result.Guesses = guesses
+	return result
+}
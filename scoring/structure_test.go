@@ -0,0 +1,79 @@
+package scoring_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustelem/zxcvbn/match"
+	"github.com/trustelem/zxcvbn/matching"
+	"github.com/trustelem/zxcvbn/scoring"
+)
+
+func TestStructure(t *testing.T) {
+	for password, structure := range map[string]string{
+		"":            "",
+		"password":    "L8",
+		"Password12!": "L8D2S1",
+		"jan.2019":    "L3S1D4",
+		"1qaz2wsx":    "D1L3D1L3",
+		"пароль12":    "L6D2",
+		"٢٠١٩!!":      "D4S2",
+	} {
+		assert.Equal(t, structure, scoring.Structure(password), password)
+	}
+}
+
+func TestStructureModel(t *testing.T) {
+	sm := scoring.NewStructureModel(map[string]int{"L6D2": 3, "L8": 1, "D4": 0})
+	assert.Equal(t, 0.75, sm.Probability("L6D2"))
+	assert.Equal(t, 0.25, sm.Probability("L8"))
+	assert.Equal(t, float64(0), sm.Probability("D4"))
+	assert.Equal(t, float64(0), sm.Probability("S1"))
+
+	sm = scoring.LearnStructureModel([]string{"monkey12", "dragon99", "", "password"})
+	assert.InDelta(t, 2.0/3, sm.Probability("L6D2"), 1e-9)
+
+	// short words are the commonest passwords of the frequency list
+	assert.Greater(t, scoring.DefaultStructureModel.Probability("L6"), 0.25)
+	assert.Greater(t, scoring.DefaultStructureModel.Probability("L6D2"), float64(0))
+}
+
+func TestMostGuessableStructure(t *testing.T) {
+	sm := scoring.LearnStructureModel([]string{"Password1!", "Monkey12!", "Dragon12!", "abc"})
+	const password = "Falcon77!"
+	matches := matching.Omnimatch(password, nil, matching.Options{Scorer: testScorer})
+	r := testScorer.MostGuessableStructure(password, matches, sm)
+	assert.Equal(t, "L6D2S1", r.Structure)
+	assert.Equal(t, 0.5, r.Probability)
+
+	// segments are scored on their own
+	guesses := 1 / r.Probability
+	for _, token := range []string{"Falcon", "77", "!"} {
+		guesses *= testScorer.MostGuessableMatchSequence(token, matching.Omnimatch(token, nil, matching.Options{Scorer: testScorer}), false).Guesses
+	}
+	assert.Equal(t, guesses, r.Guesses)
+	if assert.Len(t, r.Sequence, 3) {
+		assert.Equal(t, "dictionary", r.Sequence[0].Pattern)
+		for k, m := range r.Sequence {
+			assert.Equal(t, password[m.I:m.J+1], m.Token, k)
+		}
+	}
+	// matches are left as they are
+	for _, m := range matches {
+		assert.Equal(t, float64(0), m.Guesses)
+	}
+
+	// structures the model has never seen aren't guessed
+	r = testScorer.MostGuessableStructure("jan.2019", nil, sm)
+	assert.Equal(t, math.Inf(1), r.Guesses)
+	assert.Empty(t, r.Sequence)
+
+	// segments without matches are bruteforced, with the additive term of a sequence of 1
+	r = testScorer.MostGuessableStructure("xqzvjk12!", []*match.Match{}, sm)
+	guesses = 2
+	for _, token := range []string{"xqzvjk", "12", "!"} {
+		guesses *= scoring.BruteforceGuesses(&match.Match{Token: token}) + 1
+	}
+	assert.Equal(t, guesses, r.Guesses)
+}
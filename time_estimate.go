@@ -52,6 +52,22 @@ func guessesToScore(guesses float64) int {
 	return 4
 }
 
+// pinGuessesToScore scores PINs, which are guessed online, where attempts are few
+// and locked out: a top 10 PIN scores 0 and a random 4 digit PIN 4.
+func pinGuessesToScore(guesses float64) int {
+	switch {
+	case guesses < 10:
+		return 0
+	case guesses < 100:
+		return 1
+	case guesses < 1000:
+		return 2
+	case guesses < 10000:
+		return 3
+	}
+	return 4
+}
+
 func displayTime(seconds float64) string {
 	minute := float64(60)
 	hour := minute * 60
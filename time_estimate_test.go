@@ -24,3 +24,16 @@ func Test_displayTime(t *testing.T) {
 		assert.Equal(t, tt.want, displayTime(tt.seconds))
 	}
 }
+
+func Test_pinGuessesToScore(t *testing.T) {
+	for guesses, want := range map[float64]int{
+		1:     0,
+		9:     0,
+		10:    1,
+		500:   2,
+		9999:  3,
+		10001: 4,
+	} {
+		assert.Equal(t, want, pinGuessesToScore(guesses), guesses)
+	}
+}
@@ -1,8 +1,10 @@
 package zxcvbn
 
 import (
+	"errors"
 	"github.com/trustelem/zxcvbn/match"
 	"time"
+	"unicode"
 	"unicode/utf8"
 
 	"github.com/trustelem/zxcvbn/matching"
@@ -14,9 +16,148 @@ type Result struct {
 	Sequence []*match.Match
 	Score    int
 	CalcTime float64
+	// Structure is the base structure of the password ("L8D2S1"), when its estimate by
+	// Options.StructureModel wins over the one of the match sequence.
+	Structure string
 }
 
+// Options configures an Estimator.
+type Options struct {
+	// ReferenceTime is the moment passwords are evaluated at: dates and years
+	// are scored by their distance to it. The zero value means the current time
+	// of each evaluation.
+	ReferenceTime time.Time
+
+	// Dictionaries are frequency lists matched along with the default ones, by the
+	// dictionary_name of their matches, such as the common words of the languages of
+	// KeyboardLayouts: "ghbdtn" is only found to be "привет" typed in the wrong layout
+	// if a dictionary has "привет". Build them once with matching.NewRankedDictionary.
+	Dictionaries map[string]*matching.RankedDictionary
+
+	// RecentYearWindow selects the years matched as recent_year around
+	// ReferenceTime. Nil stands for the 119 years before and the reference year
+	// itself, like upstream; an empty YearWindow only matches the reference year.
+	RecentYearWindow *matching.YearWindow
+
+	// TextualDates enables dates written with month and weekday names, such as
+	// "14feb", "march1987" or "friday13th".
+	TextualDates bool
+
+	// DateNames are the names recognised in textual dates. Defaults to
+	// matching.EnglishDateNames.
+	DateNames []matching.DateNames
+
+	// ExtendedDates enables two-part dates ("2024-05", "05/24"), ISO 8601 week
+	// dates and date-times ("2024-W18", "20240501T1200") and times of day ("12:30").
+	ExtendedDates bool
+
+	// UnicodeFolding enables dictionary matching of the password folded with NFKC,
+	// without diacritics and with confusable letters replaced: "pässwörd", "passwоrd"
+	// (with a Cyrillic о) or "ｐａｓｓｗｏｒｄ".
+	UnicodeFolding bool
+
+	// L33tTable lists the l33t substitutions matched in dictionary words, such as
+	// matching.ExtendedL33tTable. Defaults to matching.DefaultL33tTable.
+	L33tTable *matching.L33tTable
+
+	// MaxL33tSubs caps the number of sets of l33t substitutions tried from each position
+	// of a password; the l33t matches found from a position where the cap was reached
+	// have L33tCapped set. Defaults to matching.DefaultMaxL33tSubs; a negative value
+	// removes the cap.
+	MaxL33tSubs int
+
+	// MaxEditDistance enables dictionary matching with typos, such as "pasword" or
+	// "iloveyuo", up to this Damerau-Levenshtein distance (1 or 2).
+	MaxEditDistance int
+
+	// Abbreviations enables dictionary matching of words written without their vowels,
+	// such as "psswrd" or "drgn", and of common phonetic abbreviations, such as "luv"
+	// or "gr8".
+	Abbreviations bool
+
+	// KeyboardLayouts enables dictionary matching of words typed with the keyboard in
+	// the wrong layout, such as "ghbdtn" for "привет", with these pairs of layouts:
+	// matching.DefaultKeyboardLayoutPairs or a selection of them.
+	KeyboardLayouts []*matching.KeyboardLayoutPair
+
+	// T9 enables dictionary matching of words typed on a phone keypad, one digit per
+	// letter, such as "7277" for "pass".
+	T9 bool
+
+	// Passphrases enables matching of passphrases: 3 words or more separated by spaces
+	// or punctuation, such as "correct-horse-battery-staple", from the dictionaries or
+	// the diceware and EFF word lists.
+	Passphrases bool
+
+	// SeparatedTokens enables matching of tokens joined by a repeated separator, such as
+	// "john_smith_1985": the tokens are scored on their own and the separator is paid
+	// for once.
+	SeparatedTokens bool
+
+	// Palindromes enables matching of mirrored tokens, whose second half reverses the
+	// first, maybe around a pivot character: "abccba", "1234321" or "passssap".
+	Palindromes bool
+
+	// VariantRepeats enables matching of repeats whose repetitions differ by case, l33t
+	// substitutions or reversal, such as "abcABC", "passP4SS" or "dog!DOG!".
+	VariantRepeats bool
+
+	// SpatialVariants enables matching of keyboard walks skipping keys ("qeteu"),
+	// pressing each key several times ("qqwweerr") or switching between a keyboard and
+	// a keypad ("qwe789"), as spatial matches of another SpatialKind.
+	SpatialVariants bool
+
+	// GraphSpatialScoring prices keyboard walks with the adjacency graph they were typed
+	// on, instead of the qwerty graph for dvorak and the keypad graph for mac_keypad as
+	// upstream zxcvbn 4.4.2 does. See scoring.Scorer.
+	GraphSpatialScoring bool
+
+	// StructureModel enables the estimate of guesses by base structure, as password
+	// crackers using probabilistic grammars make it: passwords whose shape is common,
+	// such as a word followed by digits, are guessed earlier. The lower estimate wins.
+	// scoring.DefaultStructureModel is learned from the passwords frequency list.
+	StructureModel *scoring.StructureModel
+
+	// MarkovModel prices the unmatched, bruteforce segments of passwords by their
+	// probability in a character n-gram model, so that pronounceable segments such as
+	// "glimpor" take fewer guesses than random ones. scoring.DefaultMarkovModel() is
+	// trained on the frequency lists.
+	MarkovModel *scoring.MarkovModel
+
+	// CharacterClassCardinality prices the bruteforce segments of passwords with the
+	// cardinality of the character classes present in them (10 for digits, 26 for
+	// lowercase letters, the size of the Unicode block for CJK ideographs...) instead of
+	// 10 guesses per character as upstream zxcvbn 4.4.2 does. MarkovModel takes
+	// precedence. See scoring.ClassBruteforceGuesses.
+	CharacterClassCardinality bool
+}
+
+// Estimator evaluates password strength with a fixed configuration.
+// It holds no mutable state and is safe for concurrent use.
+type Estimator struct {
+	opts Options
+}
+
+// NewEstimator returns an Estimator configured with opts. It returns an error wrapping
+// matching.ErrDictionaryName if one of opts.Dictionaries is named like a default
+// dictionary or "user_inputs".
+func NewEstimator(opts Options) (*Estimator, error) {
+	if err := (matching.Options{Dictionaries: opts.Dictionaries}).Validate(); err != nil {
+		return nil, err
+	}
+	return &Estimator{opts: opts}, nil
+}
+
+var defaultEstimator = &Estimator{}
+
+// PasswordStrength evaluates password with the default Estimator.
 func PasswordStrength(password string, userInputs []string) Result {
+	return defaultEstimator.PasswordStrength(password, userInputs)
+}
+
+// PasswordStrength estimates the strength of password. userInputs (user name,
+// email, ...) are matched as an extra dictionary.
+func (e *Estimator) PasswordStrength(password string, userInputs []string) Result {
 	start := time.Now()
 	var result Result
 	if !utf8.ValidString(password) {
@@ -24,13 +165,95 @@ func PasswordStrength(password string, userInputs []string) Result {
 		// => those will be reported as weak passwords
 		return result
 	}
-	matches := matching.Omnimatch(password, userInputs)
-	seq := scoring.MostGuessableMatchSequence(password, matches, false)
+	return e.estimate(start, password, userInputs, false)
+}
+
+// ErrInvalidPIN is returned by PINStrength for PINs that contain other characters
+// than digits, or fewer than 4 or more than 8 of them.
+var ErrInvalidPIN = errors.New("zxcvbn: a PIN must be made of 4 to 8 digits")
+
+// minPINLength and maxPINLength bound the number of digits of PINs.
+const (
+	minPINLength = 4
+	maxPINLength = 8
+)
+
+// PINStrength evaluates pin with the default Estimator.
+func PINStrength(pin string) (Result, error) {
+	return defaultEstimator.PINStrength(pin)
+}
+
+// PINStrength estimates the strength of a numeric PIN, matching common PINs, keypad
+// patterns, repeats, sequences and dates, and scores it against thresholds for PINs:
+// a random 4 digit PIN scores 4. It returns ErrInvalidPIN if pin isn't made of 4 to 8
+// digits.
+func (e *Estimator) PINStrength(pin string) (Result, error) {
+	start := time.Now()
+	if !utf8.ValidString(pin) {
+		return Result{}, ErrInvalidPIN
+	}
+	if n := utf8.RuneCountInString(pin); n < minPINLength || n > maxPINLength {
+		return Result{}, ErrInvalidPIN
+	}
+	for _, r := range pin {
+		if !unicode.IsDigit(r) {
+			return Result{}, ErrInvalidPIN
+		}
+	}
+	return e.estimate(start, pin, nil, true), nil
+}
+
+func (e *Estimator) estimate(start time.Time, password string, userInputs []string, pin bool) Result {
+	var result Result
+	referenceTime := e.opts.ReferenceTime
+	if referenceTime.IsZero() {
+		referenceTime = start
+	}
+	opts := matching.Options{
+		Scorer: scoring.Scorer{
+			ReferenceYear:             referenceTime.Year(),
+			GraphSpatialScoring:       e.opts.GraphSpatialScoring,
+			Markov:                    e.opts.MarkovModel,
+			CharacterClassCardinality: e.opts.CharacterClassCardinality,
+		},
+		Dictionaries:     e.opts.Dictionaries,
+		RecentYearWindow: e.opts.RecentYearWindow,
+		TextualDates:     e.opts.TextualDates,
+		DateNames:        e.opts.DateNames,
+		ExtendedDates:    e.opts.ExtendedDates,
+		UnicodeFolding:   e.opts.UnicodeFolding,
+		L33tTable:        e.opts.L33tTable,
+		MaxL33tSubs:      e.opts.MaxL33tSubs,
+		MaxEditDistance:  e.opts.MaxEditDistance,
+		Abbreviations:    e.opts.Abbreviations,
+		KeyboardLayouts:  e.opts.KeyboardLayouts,
+		T9:               e.opts.T9,
+		Passphrases:      e.opts.Passphrases,
+		SeparatedTokens:  e.opts.SeparatedTokens,
+		Palindromes:      e.opts.Palindromes,
+		VariantRepeats:   e.opts.VariantRepeats,
+		SpatialVariants:  e.opts.SpatialVariants,
+		PIN:              pin,
+	}
+	matches := matching.Omnimatch(password, userInputs, opts)
+	seq := opts.Scorer.MostGuessableMatchSequence(password, matches, false)
+	if e.opts.StructureModel != nil && !pin {
+		structure := opts.Scorer.MostGuessableStructure(password, matches, e.opts.StructureModel)
+		if structure.Guesses < seq.Guesses {
+			seq.Guesses = structure.Guesses
+			seq.Sequence = structure.Sequence
+			result.Structure = structure.Structure
+		}
+	}
 	end := time.Now()
 	calcTime := end.Nanosecond() - start.Nanosecond()
 	result.CalcTime = round(float64(calcTime)*time.Nanosecond.Seconds(), .5, 3)
 	result.Sequence = seq.Sequence
 	result.Guesses = seq.Guesses
-	result.Score = guessesToScore(seq.Guesses)
+	if pin {
+		result.Score = pinGuessesToScore(seq.Guesses)
+	} else {
+		result.Score = guessesToScore(seq.Guesses)
+	}
 	return result
 }
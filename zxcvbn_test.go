@@ -4,17 +4,25 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"math"
 	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/trustelem/zxcvbn/match"
+	"github.com/trustelem/zxcvbn/matching"
 	"github.com/trustelem/zxcvbn/scoring"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
+func newEstimator(t *testing.T, opts Options) *Estimator {
+	e, err := NewEstimator(opts)
+	require.NoError(t, err)
+	return e
+}
+
 func TestPasswordStrength(t *testing.T) {
 	var testdata struct {
 		TimeStamp time.Time `json:"timestamp"`
@@ -32,11 +40,7 @@ func TestPasswordStrength(t *testing.T) {
 	err = json.Unmarshal(b, &testdata)
 	require.NoError(t, err)
 
-	refYear := scoring.ReferenceYear
-	defer func() {
-		scoring.ReferenceYear = refYear
-	}()
-	scoring.ReferenceYear = testdata.TimeStamp.Year()
+	estimator := newEstimator(t, Options{ReferenceTime: testdata.TimeStamp})
 	// maximum epsilon for guesses comparison
 	const maxEpsilonGuesses = 1e-15
 	for _, td := range testdata.Tests {
@@ -49,7 +53,7 @@ func TestPasswordStrength(t *testing.T) {
 				c++
 			}
 			runeMap[len(td.Password)] = c
-			s := PasswordStrength(td.Password, nil)
+			s := estimator.PasswordStrength(td.Password, nil)
 			if len(s.Sequence) == len(td.Sequence) {
 				for j := range td.Sequence {
 					expect, _ := json.Marshal(td.Sequence[j])
@@ -102,3 +106,394 @@ func TestCornerCases(t *testing.T) {
 		_ = PasswordStrength(td, nil)
 	}
 }
+
+func TestEstimatorReferenceTime(t *testing.T) {
+	// the same date is cheaper to guess when it is close to the reference time
+	near := newEstimator(t, Options{ReferenceTime: time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC)})
+	far := newEstimator(t, Options{ReferenceTime: time.Date(2090, 1, 1, 0, 0, 0, 0, time.UTC)})
+	const password = "13/3/1920"
+	assert.Less(t, near.PasswordStrength(password, nil).Guesses, far.PasswordStrength(password, nil).Guesses)
+}
+
+func TestEstimatorTextualDates(t *testing.T) {
+	ref := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	upstream := newEstimator(t, Options{ReferenceTime: ref})
+	textual := newEstimator(t, Options{ReferenceTime: ref, TextualDates: true})
+	for _, password := range []string{"march1987", "14feb", "jan01", "Dec25"} {
+		s := textual.PasswordStrength(password, nil)
+		if assert.Len(t, s.Sequence, 1, password) {
+			assert.Equal(t, "date", s.Sequence[0].Pattern, password)
+		}
+		assert.Less(t, s.Guesses, upstream.PasswordStrength(password, nil).Guesses, password)
+	}
+}
+
+func TestNonASCIIDigits(t *testing.T) {
+	estimator := newEstimator(t, Options{ReferenceTime: time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)})
+	for _, tt := range []struct {
+		password string
+		ascii    string
+	}{
+		{"x٣٤٥٦٧", "x34567"},
+		{"pass१९८७", "pass1987"},
+		{"13/02/１９８７", "13/02/1987"},
+	} {
+		s := estimator.PasswordStrength(tt.password, nil)
+		assert.Equal(t, estimator.PasswordStrength(tt.ascii, nil).Guesses, s.Guesses, tt.password)
+		for _, m := range s.Sequence {
+			assert.Equal(t, tt.password[m.I:m.J+1], m.Token, tt.password)
+		}
+	}
+}
+
+func TestUnicodeFolding(t *testing.T) {
+	ref := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	upstream := newEstimator(t, Options{ReferenceTime: ref})
+	folding := newEstimator(t, Options{ReferenceTime: ref, UnicodeFolding: true})
+	for _, password := range []string{"pässwörd", "passwоrd", "ｐａｓｓｗｏｒｄ"} {
+		s := folding.PasswordStrength(password, nil)
+		if assert.Len(t, s.Sequence, 1, password) {
+			assert.Equal(t, "password", s.Sequence[0].MatchedWord, password)
+		}
+		assert.Equal(t, 0, s.Score, password)
+	}
+	for _, password := range []string{"pässwörd", "passwоrd"} {
+		assert.Less(t, folding.PasswordStrength(password, nil).Guesses, upstream.PasswordStrength(password, nil).Guesses, password)
+	}
+}
+
+func TestL33tTable(t *testing.T) {
+	ref := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	upstream := newEstimator(t, Options{ReferenceTime: ref})
+	extended := newEstimator(t, Options{ReferenceTime: ref, L33tTable: matching.ExtendedL33tTable})
+	for _, password := range []string{"ph|_|nny", "|-|3ll()"} {
+		assert.Less(t, extended.PasswordStrength(password, nil).Guesses, upstream.PasswordStrength(password, nil).Guesses, password)
+	}
+
+	// the default table gives the same results as upstream
+	assert.Equal(t, upstream.PasswordStrength("p@ssw0rd", nil).Guesses,
+		newEstimator(t, Options{ReferenceTime: ref, L33tTable: matching.DefaultL33tTable}).PasswordStrength("p@ssw0rd", nil).Guesses)
+}
+
+func TestMaxEditDistance(t *testing.T) {
+	ref := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	upstream := newEstimator(t, Options{ReferenceTime: ref})
+	fuzzy := newEstimator(t, Options{ReferenceTime: ref, MaxEditDistance: 2})
+	for _, password := range []string{"passwrod", "iloveyuo", "sunshien", "monkye"} {
+		s := fuzzy.PasswordStrength(password, nil)
+		assert.Less(t, s.Guesses, upstream.PasswordStrength(password, nil).Guesses, password)
+		if assert.Len(t, s.Sequence, 1, password) {
+			assert.NotEmpty(t, s.Sequence[0].Edits, password)
+		}
+	}
+}
+
+func TestAbbreviations(t *testing.T) {
+	ref := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	upstream := newEstimator(t, Options{ReferenceTime: ref})
+	abbreviations := newEstimator(t, Options{ReferenceTime: ref, Abbreviations: true})
+	for _, password := range []string{"drgn", "mnky", "luv", "gr8", "luvdrgn", "kewlgrl"} {
+		s := abbreviations.PasswordStrength(password, nil)
+		assert.Less(t, s.Guesses, upstream.PasswordStrength(password, nil).Guesses, password)
+	}
+	s := abbreviations.PasswordStrength("drgn", nil)
+	if assert.Len(t, s.Sequence, 1) {
+		assert.Equal(t, "dragon", s.Sequence[0].MatchedWord)
+		assert.Equal(t, "vowels_dropped", s.Sequence[0].Abbreviation)
+	}
+}
+
+func TestKeyboardLayouts(t *testing.T) {
+	ref := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	upstream := newEstimator(t, Options{ReferenceTime: ref})
+	layouts := newEstimator(t, Options{ReferenceTime: ref, KeyboardLayouts: matching.DefaultKeyboardLayoutPairs})
+
+	// English words typed in the Russian, Greek and Hebrew layouts
+	for _, password := range []string{"зфыыцщкв", "ьщтлун", "δραγον", "ןךםהקטםו"} {
+		s := layouts.PasswordStrength(password, nil)
+		bruteforce := scoring.BruteforceGuesses(&match.Match{Token: password})
+		assert.Less(t, s.Guesses, bruteforce, password)
+		if assert.Len(t, s.Sequence, 1, password) {
+			assert.Equal(t, "keyboard_layout", s.Sequence[0].Pattern, password)
+			assert.Equal(t, "qwerty", s.Sequence[0].TargetLayout, password)
+		}
+	}
+
+	// native words typed in QWERTY are found in the extra dictionaries
+	russian := newEstimator(t, Options{
+		ReferenceTime:   ref,
+		KeyboardLayouts: matching.DefaultKeyboardLayoutPairs,
+		Dictionaries: map[string]*matching.RankedDictionary{
+			"russian_words": matching.NewRankedDictionary([]string{"и", "в", "привет", "пароль"}),
+		},
+	})
+	s := russian.PasswordStrength("ghbdtn", nil)
+	assert.Less(t, s.Guesses, upstream.PasswordStrength("ghbdtn", nil).Guesses)
+	if assert.Len(t, s.Sequence, 1) {
+		assert.Equal(t, "привет", s.Sequence[0].MatchedWord)
+		assert.Equal(t, "russian_words", s.Sequence[0].DictionaryName)
+		assert.Equal(t, 3, s.Sequence[0].Rank)
+		assert.Equal(t, "qwerty", s.Sequence[0].SourceLayout)
+		assert.Equal(t, "russian", s.Sequence[0].TargetLayout)
+	}
+	// and in the user inputs
+	s = layouts.PasswordStrength("ghbdtn", []string{"привет"})
+	assert.Less(t, s.Guesses, upstream.PasswordStrength("ghbdtn", []string{"привет"}).Guesses)
+	if assert.Len(t, s.Sequence, 1) {
+		assert.Equal(t, "привет", s.Sequence[0].MatchedWord)
+	}
+}
+
+func TestNewEstimatorDictionaryNames(t *testing.T) {
+	d := matching.NewRankedDictionary([]string{"zxcvbn"})
+	for _, name := range []string{"passwords", "english_wikipedia", "user_inputs"} {
+		e, err := NewEstimator(Options{Dictionaries: map[string]*matching.RankedDictionary{name: d}})
+		assert.ErrorIs(t, err, matching.ErrDictionaryName, name)
+		assert.Nil(t, e, name)
+	}
+	_, err := NewEstimator(Options{Dictionaries: map[string]*matching.RankedDictionary{"project_words": d}})
+	assert.NoError(t, err)
+}
+
+func TestT9(t *testing.T) {
+	ref := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	upstream := newEstimator(t, Options{ReferenceTime: ref})
+	t9 := newEstimator(t, Options{ReferenceTime: ref, T9: true})
+	// "pass", "love", "monkey" and "dragon"
+	for _, password := range []string{"7277", "5683", "666539", "372466"} {
+		s := t9.PasswordStrength(password, nil)
+		assert.Less(t, s.Guesses, upstream.PasswordStrength(password, nil).Guesses, password)
+		if assert.Len(t, s.Sequence, 1, password) {
+			assert.Equal(t, "t9", s.Sequence[0].Pattern, password)
+		}
+	}
+}
+
+func TestPassphrases(t *testing.T) {
+	ref := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	upstream := newEstimator(t, Options{ReferenceTime: ref})
+	passphrases := newEstimator(t, Options{ReferenceTime: ref, Passphrases: true})
+	for _, password := range []string{
+		"alpha bravo charlie delta",
+		"correct-horse-battery-staple",
+		"correct.horse.battery.staple",
+		"correct,horse,battery,staple",
+		"correct~horse~battery~staple",
+		"abacus-ablaze-zoology-unwoven", // EFF large list
+	} {
+		s := passphrases.PasswordStrength(password, nil)
+		assert.Less(t, s.Guesses, upstream.PasswordStrength(password, nil).Guesses, password)
+		assert.Equal(t, "passphrase", s.Sequence[0].Pattern, password)
+	}
+	s := passphrases.PasswordStrength("abacus-ablaze-zoology-unwoven", nil)
+	if assert.Len(t, s.Sequence, 1) {
+		assert.Equal(t, "eff_large", s.Sequence[0].DictionaryName)
+		assert.Equal(t, []string{"abacus", "ablaze", "zoology", "unwoven"}, s.Sequence[0].Words)
+	}
+}
+
+func TestSeparatedTokens(t *testing.T) {
+	ref := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	upstream := newEstimator(t, Options{ReferenceTime: ref})
+	separated := newEstimator(t, Options{ReferenceTime: ref, SeparatedTokens: true})
+	for _, tt := range []struct {
+		password string
+		joined   string
+	}{
+		{"john_smith_1985", "johnsmith1985"},
+		{"correct.horse.battery.staple", "correcthorsebatterystaple"},
+		{"qwerty-dragon-2019", "qwertydragon2019"},
+	} {
+		s := separated.PasswordStrength(tt.password, nil)
+		assert.Less(t, s.Guesses, upstream.PasswordStrength(tt.password, nil).Guesses, tt.password)
+		// the tokens are priced as a sequence of matches, so the separator never
+		// makes them weaker than without it
+		assert.Greater(t, s.Guesses, upstream.PasswordStrength(tt.joined, nil).Guesses, tt.password)
+		if assert.Len(t, s.Sequence, 1, tt.password) {
+			assert.Equal(t, "separated", s.Sequence[0].Pattern, tt.password)
+		}
+	}
+
+	// separated tokens are priced by their ranks, passphrases as random words
+	passphrases := newEstimator(t, Options{ReferenceTime: ref, Passphrases: true})
+	both := newEstimator(t, Options{ReferenceTime: ref, Passphrases: true, SeparatedTokens: true})
+	s := both.PasswordStrength("the the the the", nil)
+	assert.Equal(t, passphrases.PasswordStrength("the the the the", nil).Guesses, s.Guesses)
+	assert.Equal(t, "passphrase", s.Sequence[0].Pattern)
+	assert.Equal(t, upstream.PasswordStrength("the the the the", nil).Guesses,
+		separated.PasswordStrength("the the the the", nil).Guesses)
+	s = both.PasswordStrength("abacus-ablaze-zoology-unwoven", nil)
+	assert.Equal(t, "passphrase", s.Sequence[0].Pattern)
+	s = both.PasswordStrength("correct.horse.battery.staple", nil)
+	assert.Equal(t, "separated", s.Sequence[0].Pattern)
+	assert.Less(t, s.Guesses, passphrases.PasswordStrength("correct.horse.battery.staple", nil).Guesses)
+}
+
+func TestPalindromes(t *testing.T) {
+	ref := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	upstream := newEstimator(t, Options{ReferenceTime: ref})
+	palindromes := newEstimator(t, Options{ReferenceTime: ref, Palindromes: true})
+	for _, password := range []string{"abccba", "1234321", "passssap", "monkey7yeknom"} {
+		s := palindromes.PasswordStrength(password, nil)
+		assert.Less(t, s.Guesses, upstream.PasswordStrength(password, nil).Guesses, password)
+		if assert.Len(t, s.Sequence, 1, password) {
+			assert.Equal(t, "palindrome", s.Sequence[0].Pattern, password)
+		}
+	}
+}
+
+func TestVariantRepeats(t *testing.T) {
+	ref := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	upstream := newEstimator(t, Options{ReferenceTime: ref})
+	variants := newEstimator(t, Options{ReferenceTime: ref, VariantRepeats: true})
+	for _, password := range []string{"abcABC", "passP4SS", "dog!DOG!", "monkeyMONKEY"} {
+		s := variants.PasswordStrength(password, nil)
+		assert.Less(t, s.Guesses, upstream.PasswordStrength(password, nil).Guesses, password)
+		if assert.Len(t, s.Sequence, 1, password) {
+			assert.Equal(t, "repeat", s.Sequence[0].Pattern, password)
+			assert.NotEmpty(t, s.Sequence[0].RepeatVariants, password)
+		}
+	}
+	// plain repeats score as upstream
+	assert.Equal(t, upstream.PasswordStrength("abcabc", nil).Guesses, variants.PasswordStrength("abcabc", nil).Guesses)
+}
+
+func TestSpatialVariants(t *testing.T) {
+	ref := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	upstream := newEstimator(t, Options{ReferenceTime: ref})
+	variants := newEstimator(t, Options{ReferenceTime: ref, SpatialVariants: true})
+	for password, kind := range map[string]string{"qetu": "gap", "zcbm": "gap", "qqwweerr": "doubled", "aassddff": "doubled"} {
+		s := variants.PasswordStrength(password, nil)
+		assert.Less(t, s.Guesses, upstream.PasswordStrength(password, nil).Guesses, password)
+		if assert.Len(t, s.Sequence, 1, password) {
+			assert.Equal(t, "spatial", s.Sequence[0].Pattern, password)
+			assert.Equal(t, kind, s.Sequence[0].SpatialKind, password)
+		}
+	}
+	// plain walks score as upstream
+	assert.Equal(t, upstream.PasswordStrength("qwerty", nil).Guesses, variants.PasswordStrength("qwerty", nil).Guesses)
+}
+
+func TestGraphSpatialScoring(t *testing.T) {
+	ref := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	upstream := newEstimator(t, Options{ReferenceTime: ref})
+	graphs := newEstimator(t, Options{ReferenceTime: ref, GraphSpatialScoring: true})
+
+	// "=/*-" is only a walk on the mac keypad, which has one more key than the keypad
+	s := graphs.PasswordStrength("=/*-", nil)
+	if assert.Len(t, s.Sequence, 1) {
+		assert.Equal(t, "mac_keypad", s.Sequence[0].Graph)
+	}
+	assert.Greater(t, s.Guesses, upstream.PasswordStrength("=/*-", nil).Guesses)
+
+	// walks on qwerty and keypad score as upstream
+	for _, password := range []string{"zxcvbn", "7410"} {
+		assert.Equal(t, upstream.PasswordStrength(password, nil).Guesses, graphs.PasswordStrength(password, nil).Guesses, password)
+	}
+}
+
+func TestStructureModel(t *testing.T) {
+	ref := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	upstream := newEstimator(t, Options{ReferenceTime: ref})
+	learned := newEstimator(t, Options{
+		ReferenceTime:  ref,
+		StructureModel: scoring.LearnStructureModel([]string{"Password1!", "Monkey12!", "Dragon12!", "Summer2019!"}),
+	})
+	s := learned.PasswordStrength("Falcon77!", nil)
+	assert.Less(t, s.Guesses, upstream.PasswordStrength("Falcon77!", nil).Guesses)
+	assert.Equal(t, "L6D2S1", s.Structure)
+	assert.Len(t, s.Sequence, 3)
+
+	// the match sequence wins for passwords of a rare shape or made of a single pattern
+	for _, password := range []string{"jan.2019", "monkey12", "zxcvbn"} {
+		s := learned.PasswordStrength(password, nil)
+		assert.Equal(t, upstream.PasswordStrength(password, nil).Guesses, s.Guesses, password)
+		assert.Empty(t, s.Structure, password)
+	}
+	defaults := newEstimator(t, Options{ReferenceTime: ref, StructureModel: scoring.DefaultStructureModel})
+	assert.Equal(t, upstream.PasswordStrength("password", nil).Guesses, defaults.PasswordStrength("password", nil).Guesses)
+}
+
+func TestMarkovModel(t *testing.T) {
+	ref := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	upstream := newEstimator(t, Options{ReferenceTime: ref})
+	markov := newEstimator(t, Options{ReferenceTime: ref, MarkovModel: scoring.DefaultMarkovModel()})
+
+	// bruteforce guesses only depend on the length of tokens
+	assert.Equal(t, upstream.PasswordStrength("glimpor", nil).Guesses, upstream.PasswordStrength("qxjzvkw", nil).Guesses)
+
+	pronounceable := markov.PasswordStrength("glimpor", nil)
+	random := markov.PasswordStrength("qxjzvkw", nil)
+	require.Len(t, pronounceable.Sequence, 1)
+	assert.Equal(t, "bruteforce", pronounceable.Sequence[0].Pattern)
+	assert.Less(t, pronounceable.Guesses*1000, random.Guesses)
+
+	// matches are scored as before
+	assert.Equal(t, upstream.PasswordStrength("tianya", nil).Guesses, markov.PasswordStrength("tianya", nil).Guesses)
+}
+
+func TestCharacterClassCardinality(t *testing.T) {
+	ref := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	upstream := newEstimator(t, Options{ReferenceTime: ref})
+	classes := newEstimator(t, Options{ReferenceTime: ref, CharacterClassCardinality: true})
+
+	// bruteforce guesses only depend on the length of tokens
+	assert.Equal(t, 1e7+1, upstream.PasswordStrength("qxjzvkw", nil).Guesses)
+	assert.Equal(t, 1e4+1, upstream.PasswordStrength("密码密碼", nil).Guesses)
+
+	for _, tt := range []struct {
+		password string
+		guesses  float64
+	}{
+		{"qxjzvkw", math.Pow(26, 7) + 1},
+		{"qxJZvkw", math.Pow(52, 7) + 1},
+		{"密码密碼", math.Pow(20992, 4) + 1},
+	} {
+		result := classes.PasswordStrength(tt.password, nil)
+		require.Len(t, result.Sequence, 1, tt.password)
+		assert.Equal(t, "bruteforce", result.Sequence[0].Pattern, tt.password)
+		assert.Equal(t, tt.guesses, result.Guesses, tt.password)
+	}
+}
+
+func TestPINStrength(t *testing.T) {
+	ref := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	e := newEstimator(t, Options{ReferenceTime: ref})
+	for _, tt := range []struct {
+		pin     string
+		pattern string
+		score   int
+	}{
+		{"1234", "dictionary", 0},
+		{"123456", "dictionary", 0},
+		{"2580", "dictionary", 2},
+		{"5150", "dictionary", 1},
+		{"1987", "regex", 1},
+		{"0612", "date", 2},
+		{"8362", "bruteforce", 4},
+		{"٥١٥٠", "dictionary", 1}, // Arabic-Indic digits
+	} {
+		s, err := e.PINStrength(tt.pin)
+		require.NoError(t, err, tt.pin)
+		if assert.Len(t, s.Sequence, 1, tt.pin) {
+			assert.Equal(t, tt.pattern, s.Sequence[0].Pattern, tt.pin)
+		}
+		assert.Equal(t, tt.score, s.Score, tt.pin)
+	}
+
+	// keypad shapes and repeats
+	s, err := e.PINStrength("0258")
+	require.NoError(t, err)
+	assert.Equal(t, "spatial", s.Sequence[0].Pattern)
+	assert.Less(t, s.Guesses, float64(10000))
+	s, err = e.PINStrength("13571357")
+	require.NoError(t, err)
+	assert.Equal(t, "repeat", s.Sequence[0].Pattern)
+
+	for _, invalid := range []string{"", "12a4", "1234 ", "\xff", "12-34", "123", "123456789"} {
+		_, err := e.PINStrength(invalid)
+		assert.Equal(t, ErrInvalidPIN, err, invalid)
+	}
+	_, err = PINStrength("1234")
+	assert.NoError(t, err)
+}